@@ -110,7 +110,6 @@ import (
 	evmencoding "github.com/cosmos/evm/encoding"
 	evmaddress "github.com/cosmos/evm/encoding/address"
 	evmmempool "github.com/cosmos/evm/mempool"
-	precompiletypes "github.com/cosmos/evm/precompiles/types"
 	cosmosevmserver "github.com/cosmos/evm/server"
 	srvflags "github.com/cosmos/evm/server/flags"
 	"github.com/cosmos/evm/x/erc20"
@@ -256,6 +255,10 @@ type App struct {
 
 	// Module configurator
 	configurator module.Configurator
+
+	// Invariants asserted every invCheckPeriod blocks, zero disables them
+	invariants     invariantRegistry
+	invCheckPeriod uint
 }
 
 func init() {
@@ -524,6 +527,19 @@ func New(
 		authAddr,
 	)
 
+	// ── Gnodi custom distro module ───────────────────────────────────────────────
+	// Built ahead of the EVM keeper, which registers the distro precompile.
+	app.DistroKeeper = distromodulekeeper.NewKeeper(
+		runtime.NewKVStoreService(keys[distromoduletypes.StoreKey]),
+		appCodec,
+		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+		authtypes.NewModuleAddress(govtypes.ModuleName),
+		app.BankKeeper,
+		app.AccountKeeper,
+		app.DistrKeeper,
+		&app.Erc20Keeper,
+	)
+
 	// ── Cosmos EVM keepers ──────────────────────────────────────────────────────
 
 	app.FeeMarketKeeper = feemarketkeeper.NewKeeper(
@@ -562,19 +578,7 @@ func New(
 		ExtendedDenom: "aGNOD",
 		DisplayDenom:  "GNOD",
 		Decimals:      evmtypes.SixDecimals.Uint32(),
-	}).WithStaticPrecompiles(
-		precompiletypes.DefaultStaticPrecompiles(
-			*app.StakingKeeper,
-			app.DistrKeeper,
-			app.PreciseBankKeeper,
-			&app.Erc20Keeper,
-			&app.TransferKeeper,
-			app.IBCKeeper.ChannelKeeper,
-			app.GovKeeper,
-			app.SlashingKeeper,
-			appCodec,
-		),
-	)
+	}).WithStaticPrecompiles(app.staticPrecompiles(appCodec))
 
 	app.Erc20Keeper = erc20keeper.NewKeeper(
 		keys[erc20types.StoreKey],
@@ -594,14 +598,11 @@ func New(
 		panic(err)
 	}
 
-	// ── Gnodi custom distro module ───────────────────────────────────────────────
-	app.DistroKeeper = distromodulekeeper.NewKeeper(
-		runtime.NewKVStoreService(keys[distromoduletypes.StoreKey]),
-		appCodec,
-		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
-		authtypes.NewModuleAddress(govtypes.ModuleName),
-		app.BankKeeper,
-		app.AccountKeeper,
+	// Per-epoch automatic minting of x/distro is driven by x/epochs.
+	app.EpochsKeeper.SetHooks(
+		epochstypes.NewMultiEpochHooks(
+			app.DistroKeeper.Hooks(),
+		),
 	)
 
 	// ── Module manager ──────────────────────────────────────────────────────────
@@ -760,6 +761,9 @@ func New(
 	app.SetBeginBlocker(app.BeginBlocker)
 	app.SetEndBlocker(app.EndBlocker)
 
+	app.invCheckPeriod = cast.ToUint(appOpts.Get(sdkserver.FlagInvCheckPeriod))
+	app.registerInvariants()
+
 	app.setAnteHandler(app.txConfig, maxGasWanted)
 	app.setPostHandler()
 
@@ -832,7 +836,12 @@ func (app *App) BeginBlocker(ctx sdk.Context) (sdk.BeginBlock, error) {
 
 // EndBlocker runs the end-block logic for every block.
 func (app *App) EndBlocker(ctx sdk.Context) (sdk.EndBlock, error) {
-	return app.ModuleManager.EndBlock(ctx)
+	res, err := app.ModuleManager.EndBlock(ctx)
+	if err != nil {
+		return res, err
+	}
+	app.assertInvariantsEndBlock(ctx)
+	return res, nil
 }
 
 // PreBlocker runs the pre-block logic for every block.
@@ -906,7 +915,16 @@ func (app *App) DefaultGenesis() map[string]json.RawMessage {
 	// params.EvmDenom to determine the coin's display name and decimal precision.
 	var bankGenState banktypes.GenesisState
 	app.appCodec.MustUnmarshalJSON(genesis[banktypes.ModuleName], &bankGenState)
-	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, banktypes.Metadata{
+	bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, NativeDenomMetadata())
+	genesis[banktypes.ModuleName] = app.appCodec.MustMarshalJSON(&bankGenState)
+
+	return genesis
+}
+
+// NativeDenomMetadata returns the bank denom metadata of the native EVM denom
+// (uGNOD), which x/vm requires at genesis.
+func NativeDenomMetadata() banktypes.Metadata {
+	return banktypes.Metadata{
 		Description: "Gnodi native token",
 		Base:        evmtypes.GetEVMCoinDenom(),
 		DenomUnits: []*banktypes.DenomUnit{
@@ -916,10 +934,7 @@ func (app *App) DefaultGenesis() map[string]json.RawMessage {
 		Name:    "Gnodi",
 		Symbol:  evmtypes.GetEVMCoinDisplayDenom(),
 		Display: evmtypes.GetEVMCoinDisplayDenom(),
-	})
-	genesis[banktypes.ModuleName] = app.appCodec.MustMarshalJSON(&bankGenState)
-
-	return genesis
+	}
 }
 
 // RegisterAPIRoutes registers all application module routes with the provided API server.
@@ -8,6 +8,8 @@ import (
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
+
+	distromoduletypes "github.com/gnodi-network/gnodi/x/distro/types"
 )
 
 // GenesisState of the blockchain is represented here as a map of raw json
@@ -25,6 +27,8 @@ type GenesisState map[string]json.RawMessage
 // precompile that was exploited on Saga chain (January 2026). The fix ships in
 // cosmos/evm v0.6.0, which has breaking API changes requiring a separate migration.
 // Until that upgrade is performed, the ICS20 precompile must remain disabled.
+// The x/distro precompile (0x...0900) is enabled on existing chains by the
+// DistroPrecompileUpgradeName upgrade.
 var activePrecompiles = []string{
 	evmtypes.P256PrecompileAddress,
 	evmtypes.Bech32PrecompileAddress,
@@ -34,6 +38,7 @@ var activePrecompiles = []string{
 	evmtypes.BankPrecompileAddress,
 	evmtypes.GovPrecompileAddress,
 	evmtypes.SlashingPrecompileAddress,
+	distromoduletypes.PrecompileAddress,
 }
 
 // NewEVMGenesisState returns the default genesis state for the x/vm module.
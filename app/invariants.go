@@ -0,0 +1,64 @@
+package app
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distromodulekeeper "github.com/gnodi-network/gnodi/x/distro/keeper"
+)
+
+// invariantRoute is an invariant registered by a module under a route.
+type invariantRoute struct {
+	moduleName string
+	route      string
+	invariant  sdk.Invariant
+}
+
+// invariantRegistry collects the module invariants. It stands in for the
+// x/crisis keeper, which the app does not include.
+type invariantRegistry struct {
+	routes []invariantRoute
+}
+
+// RegisterRoute implements sdk.InvariantRegistry.
+func (r *invariantRegistry) RegisterRoute(moduleName, route string, invariant sdk.Invariant) { //nolint:staticcheck // x/crisis is not included
+	r.routes = append(r.routes, invariantRoute{moduleName: moduleName, route: route, invariant: invariant})
+}
+
+// registerInvariants registers the invariants checked by AssertInvariants.
+func (app *App) registerInvariants() {
+	distromodulekeeper.RegisterInvariants(&app.invariants, app.DistroKeeper)
+}
+
+// AssertInvariants runs the registered invariants of the given modules, or
+// all of them when no module is given, against ctx. It returns an error
+// describing every broken invariant.
+func (app *App) AssertInvariants(ctx sdk.Context, modules ...string) error {
+	var broken []string
+	for _, r := range app.invariants.routes {
+		if len(modules) > 0 && !slices.Contains(modules, r.moduleName) {
+			continue
+		}
+		if msg, stop := r.invariant(ctx); stop {
+			broken = append(broken, msg)
+		}
+	}
+	if len(broken) > 0 {
+		return fmt.Errorf("invariant broken at height %d:\n%s", ctx.BlockHeight(), strings.Join(broken, ""))
+	}
+	return nil
+}
+
+// assertInvariantsEndBlock asserts all invariants every invCheckPeriod blocks,
+// halting the chain like x/crisis when one of them is broken.
+func (app *App) assertInvariantsEndBlock(ctx sdk.Context) {
+	if app.invCheckPeriod == 0 || ctx.BlockHeight()%int64(app.invCheckPeriod) != 0 {
+		return
+	}
+	if err := app.AssertInvariants(ctx); err != nil {
+		panic(err)
+	}
+}
@@ -0,0 +1,42 @@
+package app
+
+import (
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	precompiletypes "github.com/cosmos/evm/precompiles/types"
+
+	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	distromodulekeeper "github.com/gnodi-network/gnodi/x/distro/keeper"
+	distroprecompile "github.com/gnodi-network/gnodi/x/distro/precompile"
+	distromoduletypes "github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// staticPrecompiles returns the cosmos/evm default static precompiles together
+// with the Gnodi distro precompile. Which of them are callable is decided by
+// the x/vm ActiveStaticPrecompiles param (see activePrecompiles).
+func (app *App) staticPrecompiles(appCodec codec.Codec) map[common.Address]vm.PrecompiledContract {
+	precompiles := precompiletypes.DefaultStaticPrecompiles(
+		*app.StakingKeeper,
+		app.DistrKeeper,
+		app.PreciseBankKeeper,
+		&app.Erc20Keeper,
+		&app.TransferKeeper,
+		app.IBCKeeper.ChannelKeeper,
+		app.GovKeeper,
+		app.SlashingKeeper,
+		appCodec,
+	)
+
+	precompiles[common.HexToAddress(distromoduletypes.PrecompileAddress)] = distroprecompile.NewPrecompile(
+		distromodulekeeper.NewMsgServerImpl(app.DistroKeeper),
+		distromodulekeeper.NewQueryServerImpl(app.DistroKeeper),
+		app.PreciseBankKeeper,
+		evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()),
+	)
+
+	return precompiles
+}
@@ -0,0 +1,20 @@
+//go:build !test
+
+package app
+
+import "testing"
+
+// evmConfigSet records that an app of this process already set the process
+// wide EVM configuration in its x/vm InitGenesis.
+var evmConfigSet bool
+
+// resetEVMConfig is called before the x/vm InitGenesis of an app. The EVM
+// configuration can only be cleared under the test build tag, so the test is
+// skipped when an earlier app already set it and the new app would fail to
+// set it again.
+func resetEVMConfig(tb testing.TB) {
+	if evmConfigSet {
+		tb.Skip("simulating several apps in one process requires -tags test")
+	}
+	evmConfigSet = true
+}
@@ -0,0 +1,21 @@
+//go:build test
+
+package app
+
+import (
+	"testing"
+
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	"github.com/stretchr/testify/require"
+)
+
+// resetEVMConfig clears the process wide EVM configuration, so that the x/vm
+// InitGenesis of the next app can set it. It is called after the app and its
+// default genesis are created, as under the test build tag the x/vm keeper
+// already sets the EVM coin info when the app is created. The chain config is
+// only set when the app is created, so it is kept.
+func resetEVMConfig(tb testing.TB) {
+	chainConfig := evmtypes.GetChainConfig()
+	evmtypes.NewEVMConfigurator().ResetTestConfig()
+	require.NoError(tb, evmtypes.SetChainConfig(chainConfig))
+}
@@ -21,19 +21,29 @@ import (
 	dbm "github.com/cosmos/cosmos-db"
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client/flags"
+	"github.com/cosmos/cosmos-sdk/server"
 	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
 	simulationtypes "github.com/cosmos/cosmos-sdk/types/simulation"
 	authzkeeper "github.com/cosmos/cosmos-sdk/x/authz/keeper"
+	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/simulation"
 	simcli "github.com/cosmos/cosmos-sdk/x/simulation/client/cli"
 	slashingtypes "github.com/cosmos/cosmos-sdk/x/slashing/types"
 	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmtypes "github.com/cosmos/evm/x/vm/types"
+	ethparams "github.com/ethereum/go-ethereum/params"
 	"github.com/spf13/viper"
 	"github.com/stretchr/testify/require"
 )
 
 const (
 	SimAppChainID = "gnodi-simapp"
+
+	// simInvCheckPeriod asserts the registered invariants after every
+	// simulated block.
+	simInvCheckPeriod = 1
 )
 
 var FlagEnableStreamingValue bool
@@ -56,6 +66,52 @@ func interBlockCacheOpt() func(*baseapp.BaseApp) {
 	return baseapp.SetInterBlockCache(store.NewCommitKVStoreCacheManager())
 }
 
+// simAppStateFn returns the randomized genesis state of app. The bank
+// simulation replaces the bank genesis, so the native denom metadata that x/vm
+// requires is added back. The default genesis and the metadata are read from
+// the EVM configuration when the function is created, so that it can be reset
+// before the genesis is initialized.
+func simAppStateFn(app *App) simulationtypes.AppStateFn {
+	metadata := NativeDenomMetadata()
+	return simtestutil.AppStateFnWithExtendedCb(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis(), func(rawState map[string]json.RawMessage) {
+		var bankGenState banktypes.GenesisState
+		app.AppCodec().MustUnmarshalJSON(rawState[banktypes.ModuleName], &bankGenState)
+
+		for _, m := range bankGenState.DenomMetadata {
+			if m.Base == metadata.Base {
+				return
+			}
+		}
+		bankGenState.DenomMetadata = append(bankGenState.DenomMetadata, metadata)
+		rawState[banktypes.ModuleName] = app.AppCodec().MustMarshalJSON(&bankGenState)
+	})
+}
+
+// simOperations returns the weighted operations of the app modules, signed
+// with the app tx config so that addresses use the app bech32 prefixes. The
+// app registers no legacy gov proposal handlers, so legacy content proposals
+// are not simulated.
+func simOperations(app *App, config simulationtypes.Config) []simulationtypes.WeightedOperation {
+	simState := module.SimulationState{
+		AppParams: make(simulationtypes.AppParams),
+		Cdc:       app.AppCodec(),
+		TxConfig:  app.TxConfig(),
+		BondDenom: sdk.DefaultBondDenom,
+	}
+	if config.ParamsFile != "" {
+		bz, err := os.ReadFile(config.ParamsFile)
+		if err != nil {
+			panic(err)
+		}
+		if err := json.Unmarshal(bz, &simState.AppParams); err != nil {
+			panic(err)
+		}
+	}
+
+	simState.ProposalMsgs = app.SimulationManager().GetProposalMsgs(simState)
+	return app.SimulationManager().WeightedOperations(simState)
+}
+
 // BenchmarkSimulation run the chain simulation
 // Running using starport command:
 // `ignite chain simulate -v --numBlocks 200 --blockSize 50`
@@ -83,18 +139,21 @@ func BenchmarkSimulation(b *testing.B) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simInvCheckPeriod
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(b, Name, bApp.Name())
+	appStateFn := simAppStateFn(bApp)
+	resetEVMConfig(b)
 
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		b,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		appStateFn,
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -127,21 +186,24 @@ func TestFullAppSimulation(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simInvCheckPeriod
 
 	app := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	if !simcli.FlagSigverifyTxValue {
 		app.SetNotSigverifyTx()
 	}
 	require.Equal(t, "gnodi", app.Name())
+	appStateFn := simAppStateFn(app)
+	resetEVMConfig(t)
 
 	// run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t,
 		os.Stdout,
 		app.BaseApp,
-		simtestutil.AppStateFn(app.AppCodec(), app.SimulationManager(), app.DefaultGenesis()),
+		appStateFn,
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(app, app.AppCodec(), config),
+		simOperations(app, config),
 		BlockedAddresses(),
 		config,
 		app.AppCodec(),
@@ -174,18 +236,21 @@ func TestAppImportExport(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simInvCheckPeriod
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, bApp.Name())
+	appStateFn := simAppStateFn(bApp)
+	resetEVMConfig(t)
 
 	// Run randomized simulation
 	_, simParams, simErr := simulation.SimulateFromSeed(
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		appStateFn,
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -217,6 +282,7 @@ func TestAppImportExport(t *testing.T) {
 
 	newApp := New(log.NewNopLogger(), newDB, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, newApp.Name())
+	resetEVMConfig(t)
 
 	var genesisState GenesisState
 	err = json.Unmarshal(exported.AppState, &genesisState)
@@ -251,6 +317,9 @@ func TestAppImportExport(t *testing.T) {
 		authzkeeper.StoreKey:   {authzkeeper.GrantQueuePrefix},
 		feegrant.StoreKey:      {feegrant.FeeAllowanceQueueKeyPrefix},
 		slashingtypes.StoreKey: {slashingtypes.ValidatorMissedBlockBitmapKeyPrefix},
+		// Simulated blocks have no header hash, so the EIP-2935 history
+		// contract stores empty block hashes that are exported as zero hashes.
+		evmtypes.StoreKey: {evmtypes.AddressStoragePrefix(ethparams.HistoryStorageAddress)},
 	}
 
 	storeKeys := bApp.GetStoreKeys()
@@ -294,18 +363,21 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	appOptions := make(simtestutil.AppOptionsMap, 0)
 	appOptions[flags.FlagHome] = DefaultNodeHome
+	appOptions[server.FlagInvCheckPeriod] = simInvCheckPeriod
 
 	bApp := New(logger, db, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, bApp.Name())
+	appStateFn := simAppStateFn(bApp)
+	resetEVMConfig(t)
 
 	// Run randomized simulation
 	stopEarly, simParams, simErr := simulation.SimulateFromSeed(
 		t,
 		os.Stdout,
 		bApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		appStateFn,
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+		simOperations(bApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -342,6 +414,7 @@ func TestAppSimulationAfterImport(t *testing.T) {
 
 	newApp := New(log.NewNopLogger(), newDB, nil, true, appOptions, fauxMerkleModeOpt, baseapp.SetChainID(SimAppChainID))
 	require.Equal(t, Name, newApp.Name())
+	resetEVMConfig(t)
 
 	_, err = newApp.InitChain(&abci.RequestInitChain{
 		AppStateBytes: exported.AppState,
@@ -353,9 +426,9 @@ func TestAppSimulationAfterImport(t *testing.T) {
 		t,
 		os.Stdout,
 		newApp.BaseApp,
-		simtestutil.AppStateFn(bApp.AppCodec(), bApp.SimulationManager(), bApp.DefaultGenesis()),
+		appStateFn,
 		simulationtypes.RandomAccounts,
-		simtestutil.SimulationOperations(newApp, newApp.AppCodec(), config),
+		simOperations(newApp, config),
 		BlockedAddresses(),
 		config,
 		bApp.AppCodec(),
@@ -395,6 +468,7 @@ func TestAppStateDeterminism(t *testing.T) {
 		}
 	}
 	appOptions.SetDefault(flags.FlagHome, DefaultNodeHome)
+	appOptions.SetDefault(server.FlagInvCheckPeriod, simInvCheckPeriod)
 	if simcli.FlagVerboseValue {
 		appOptions.SetDefault(flags.FlagLogLevel, "debug")
 	}
@@ -423,6 +497,8 @@ func TestAppStateDeterminism(t *testing.T) {
 				interBlockCacheOpt(),
 				baseapp.SetChainID(SimAppChainID),
 			)
+			appStateFn := simAppStateFn(bApp)
+			resetEVMConfig(t)
 
 			fmt.Printf(
 				"running non-determinism simulation; seed %d: %d/%d, attempt: %d/%d\n",
@@ -433,13 +509,9 @@ func TestAppStateDeterminism(t *testing.T) {
 				t,
 				os.Stdout,
 				bApp.BaseApp,
-				simtestutil.AppStateFn(
-					bApp.AppCodec(),
-					bApp.SimulationManager(),
-					bApp.DefaultGenesis(),
-				),
+				appStateFn,
 				simulationtypes.RandomAccounts,
-				simtestutil.SimulationOperations(bApp, bApp.AppCodec(), config),
+				simOperations(bApp, config),
 				BlockedAddresses(),
 				config,
 				bApp.AppCodec(),
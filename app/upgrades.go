@@ -2,6 +2,9 @@ package app
 
 import (
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
 
 	sdkmath "cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
@@ -11,11 +14,14 @@ import (
 	"github.com/cosmos/cosmos-sdk/types/module"
 	banktypes "github.com/cosmos/cosmos-sdk/x/bank/types"
 	"github.com/cosmos/cosmos-sdk/x/group"
+	"github.com/ethereum/go-ethereum/common"
 
 	erc20types "github.com/cosmos/evm/x/erc20/types"
 	feemarkettypes "github.com/cosmos/evm/x/feemarket/types"
 	evmtypes "github.com/cosmos/evm/x/vm/types"
 	precisebanktypes "github.com/cosmos/evm/x/precisebank/types"
+
+	distromoduletypes "github.com/gnodi-network/gnodi/x/distro/types"
 )
 
 const (
@@ -23,6 +29,10 @@ const (
 	// This upgrade adds x/vm, x/feemarket, x/erc20, and x/precisebank modules,
 	// and disables x/group via the circuit breaker.
 	EVMUpgradeName = "evm-upgrade"
+
+	// DistroPrecompileUpgradeName is the on-chain upgrade name for the upgrade
+	// that enables the x/distro EVM precompile.
+	DistroPrecompileUpgradeName = "distro-precompile"
 )
 
 // groupMsgTypeURLs lists all x/group message type URLs to be disabled via the
@@ -44,6 +54,16 @@ var groupMsgTypeURLs = []string{
 	sdk.MsgTypeURL(&group.MsgLeaveGroup{}),
 }
 
+// distroPrecompileUpgradeInfo is the JSON info of the distro precompile
+// upgrade plan.
+type distroPrecompileUpgradeInfo struct {
+	// DistroMintedSupply is the supply x/distro minted before it recorded its
+	// mints. It must be set when x/distro migrates from before v4, as only
+	// the chain operator can tell it apart from the rest of the bank supply,
+	// such as genesis allocations.
+	DistroMintedSupply *sdkmath.Int `json:"distro_minted_supply"`
+}
+
 // RegisterUpgradeHandlers registers the upgrade handlers for the app.
 func (app *App) RegisterUpgradeHandlers() {
 	app.UpgradeKeeper.SetUpgradeHandler(
@@ -109,6 +129,50 @@ func (app *App) RegisterUpgradeHandlers() {
 		},
 	)
 
+	app.UpgradeKeeper.SetUpgradeHandler(
+		DistroPrecompileUpgradeName,
+		func(ctx context.Context, plan upgradetypes.Plan, fromVM module.VersionMap) (module.VersionMap, error) {
+			sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+			// Add the distro precompile to the active static precompiles. It
+			// is registered with the EVM keeper in app.go but stays uncallable
+			// until it is active. Chains that ran the EVM upgrade after the
+			// precompile was added to activePrecompiles already have it.
+			distroPrecompile := common.HexToAddress(distromoduletypes.PrecompileAddress)
+			if !slices.Contains(app.EVMKeeper.GetParams(sdkCtx).ActiveStaticPrecompiles, distroPrecompile.Hex()) {
+				if err := app.EVMKeeper.EnableStaticPrecompiles(sdkCtx, distroPrecompile); err != nil {
+					return nil, err
+				}
+			}
+
+			// The x/distro migration to v4 seeds the minted supply with the
+			// mints recorded in the mint ledger. The mints made before are
+			// taken from the plan info.
+			distroVersion, ok := fromVM[distromoduletypes.ModuleName]
+			seedMintedSupply := ok && distroVersion < 4
+			var upgradeInfo distroPrecompileUpgradeInfo
+			if seedMintedSupply {
+				if err := json.Unmarshal([]byte(plan.Info), &upgradeInfo); err != nil {
+					return nil, fmt.Errorf("invalid %s upgrade info: %w", DistroPrecompileUpgradeName, err)
+				}
+				if upgradeInfo.DistroMintedSupply == nil {
+					return nil, fmt.Errorf("%s upgrade info must set distro_minted_supply", DistroPrecompileUpgradeName)
+				}
+			}
+
+			versionMap, err := app.ModuleManager.RunMigrations(ctx, app.Configurator(), fromVM)
+			if err != nil {
+				return nil, err
+			}
+			if seedMintedSupply {
+				if err := app.DistroKeeper.AddUnrecordedMintedSupply(ctx, *upgradeInfo.DistroMintedSupply); err != nil {
+					return nil, err
+				}
+			}
+			return versionMap, nil
+		},
+	)
+
 	upgradeInfo, err := app.UpgradeKeeper.ReadUpgradeInfoFromDisk()
 	if err != nil {
 		panic(err)
@@ -45,11 +45,14 @@ func initRootCmd(rootCmd *cobra.Command, gnodiApp *app.App) {
 		return newApp(l, d, w, ao)
 	}
 
+	debugCmd := evmdebug.Cmd()
+	debugCmd.AddCommand(InvariantsCmd())
+
 	rootCmd.AddCommand(
 		InitCmd(gnodiApp, app.DefaultNodeHome),
 		genutilcli.Commands(gnodiApp.TxConfig(), gnodiApp.BasicModuleManager, app.DefaultNodeHome),
 		cmtcli.NewCompletionCmd(rootCmd, true),
-		evmdebug.Cmd(),
+		debugCmd,
 		confixcmd.ConfigCommand(),
 		pruning.Cmd(sdkAppCreator, app.DefaultNodeHome),
 		snapshot.Cmd(sdkAppCreator),
@@ -0,0 +1,72 @@
+package cmd
+
+import (
+	"errors"
+	"fmt"
+
+	"cosmossdk.io/log"
+	cmtcfg "github.com/cometbft/cometbft/config"
+	cmtproto "github.com/cometbft/cometbft/proto/tendermint/types"
+	"github.com/cometbft/cometbft/store"
+	dbm "github.com/cosmos/cosmos-db"
+	sdkserver "github.com/cosmos/cosmos-sdk/server"
+	"github.com/spf13/cobra"
+
+	"github.com/gnodi-network/gnodi/app"
+)
+
+// InvariantsCmd returns a command that asserts the registered invariants
+// against the latest state committed by the node.
+func InvariantsCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "invariants [module...]",
+		Short: "Assert the registered invariants against the latest committed state",
+		Long: `Assert the registered invariants against the latest committed state, at the
+height and block time of the last committed block. Only the invariants of the
+given modules are asserted when modules are given. The node must be stopped.`,
+		Example: "gnodid debug invariants distro",
+		RunE: func(cmd *cobra.Command, args []string) error {
+			serverCtx := sdkserver.GetServerContextFromCmd(cmd)
+			cfg := serverCtx.Config
+
+			db, err := dbm.NewDB("application", sdkserver.GetAppDBBackend(serverCtx.Viper), cfg.DBDir())
+			if err != nil {
+				return err
+			}
+			defer db.Close()
+
+			blockStoreDB, err := cmtcfg.DefaultDBProvider(&cmtcfg.DBContext{ID: "blockstore", Config: cfg})
+			if err != nil {
+				return err
+			}
+			blockStore := store.NewBlockStore(blockStoreDB)
+			defer blockStore.Close()
+
+			gnodiApp, ok := newApp(log.NewNopLogger(), db, nil, serverCtx.Viper).(*app.App)
+			if !ok {
+				return errors.New("app created from newApp is not of type App")
+			}
+
+			height := gnodiApp.LastBlockHeight()
+			if height == 0 {
+				return errors.New("no committed state found")
+			}
+			meta := blockStore.LoadBlockMeta(height)
+			if meta == nil {
+				return fmt.Errorf("block %d not found in the block store", height)
+			}
+
+			ctx := gnodiApp.NewContextLegacy(true, cmtproto.Header{
+				ChainID: meta.Header.ChainID,
+				Height:  height,
+				Time:    meta.Header.Time,
+			})
+			if err := gnodiApp.AssertInvariants(ctx, args...); err != nil {
+				return err
+			}
+
+			cmd.Printf("invariants hold at height %d\n", height)
+			return nil
+		},
+	}
+}
@@ -0,0 +1,18 @@
+package keeper
+
+import (
+	"context"
+)
+
+// BeginBlocker applies the scheduled params updates that are due, drops the
+// expired mint proposals, then runs per-block automatic minting against the
+// resulting params.
+func (k Keeper) BeginBlocker(ctx context.Context) error {
+	if err := k.applyDueParamsUpdates(ctx); err != nil {
+		return err
+	}
+	if err := k.removeExpiredMintProposals(ctx); err != nil {
+		return err
+	}
+	return k.blockAutoMint(ctx)
+}
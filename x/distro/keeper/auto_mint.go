@@ -0,0 +1,110 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// GetAutoMintWatermark returns the automatic minting watermark, or the zero
+// watermark when automatic minting never ran.
+func (k Keeper) GetAutoMintWatermark(ctx context.Context) (types.AutoMintWatermark, error) {
+	watermark, err := k.AutoMintWatermark.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.AutoMintWatermark{Released: math.ZeroInt()}, nil
+	}
+	return watermark, err
+}
+
+// AutoMint mints the distributable amount unlocked since the watermark and
+// distributes it like a MsgMint. The amount is bounded by the amount still
+// mintable under the distributable cap and the max supply, so supply minted
+// through MsgMint is never minted twice. Because the watermark is persisted,
+// blocks or epochs that are skipped are caught up on the next run, including
+// the ones skipped while minting was paused.
+func (k Keeper) AutoMint(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return err
+	}
+	if paused {
+		return nil
+	}
+	if params.ReceivingAddress == "" {
+		// Nothing can be delivered before the chain operator configured the
+		// receiving address.
+		return nil
+	}
+
+	schedule, err := scheduleAt(params, sdkCtx.BlockTime())
+	if err != nil {
+		return err
+	}
+	if schedule.HalvingPeriod == 0 {
+		return nil
+	}
+
+	watermark, err := k.GetAutoMintWatermark(ctx)
+	if err != nil {
+		return err
+	}
+
+	if schedule.TotalDistributable.LTE(watermark.Released) {
+		return nil
+	}
+	mintable, err := k.mintableAmount(ctx, params, schedule)
+	if err != nil {
+		return err
+	}
+	amount := math.MinInt(schedule.TotalDistributable.Sub(watermark.Released), mintable)
+	if !amount.IsPositive() {
+		return nil
+	}
+
+	signer, err := k.addressCodec.BytesToString(k.accountKeeper.GetModuleAddress(types.ModuleName))
+	if err != nil {
+		return err
+	}
+	if _, err := k.mintAndDistribute(sdkCtx, signer, params, schedule, amount, "", ""); err != nil {
+		return err
+	}
+
+	return k.AutoMintWatermark.Set(ctx, types.AutoMintWatermark{
+		Released:    watermark.Released.Add(amount),
+		BlockHeight: sdkCtx.BlockHeight(),
+		BlockTime:   sdkCtx.BlockTime(),
+	})
+}
+
+// blockAutoMint mints automatically when per-block automatic minting is
+// enabled. Failures are logged and discarded so that a misconfiguration can
+// never halt the chain.
+func (k Keeper) blockAutoMint(ctx context.Context) error {
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoMint != types.AutoMintMode_AUTO_MINT_MODE_BLOCK {
+		return nil
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	cacheCtx, write := sdkCtx.CacheContext()
+	if err := k.AutoMint(cacheCtx); err != nil {
+		sdkCtx.Logger().Error("automatic mint failed", "module", types.ModuleName, "err", err)
+		return nil
+	}
+	write()
+	return nil
+}
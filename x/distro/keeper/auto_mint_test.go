@@ -0,0 +1,128 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// setupAutoMint configures automatic minting in the given mode on top of
+// setupMint.
+func setupAutoMint(t *testing.T, f *fixture, mode types.AutoMintMode) (sdk.Context, types.Params) {
+	t.Helper()
+
+	ctx, params, _ := setupMint(t, f)
+	params.AutoMint = mode
+	params.AutoMintEpochIdentifier = "day"
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	return ctx, params
+}
+
+// unlockedAt returns the cumulative distributable cap after days days of the
+// first halving period under the default params.
+func unlockedAt(days int64) math.Int {
+	return types.DefaultMaxSupply.QuoRaw(2).MulRaw(days).QuoRaw(365)
+}
+
+func TestBeginBlockerAutoMintDisabled(t *testing.T) {
+	f := initFixture(t)
+	ctx, params := setupAutoMint(t, f, types.AutoMintMode_AUTO_MINT_MODE_DISABLED)
+
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).IsZero())
+}
+
+func TestBeginBlockerAutoMint(t *testing.T) {
+	f := initFixture(t)
+	ctx, params := setupAutoMint(t, f, types.AutoMintMode_AUTO_MINT_MODE_BLOCK)
+	ctx = ctx.WithBlockHeight(10)
+
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	requireIntEqual(t, unlockedAt(184), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+	requireIntEqual(t, unlockedAt(184), f.bankKeeper.balances[params.ReceivingAddress].AmountOf(params.Denom))
+
+	watermark, err := f.keeper.GetAutoMintWatermark(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, unlockedAt(184), watermark.Released)
+	require.Equal(t, int64(10), watermark.BlockHeight)
+	require.Equal(t, ctx.BlockTime(), watermark.BlockTime)
+
+	record, err := f.keeper.Mints.Get(ctx, 0)
+	require.NoError(t, err)
+	require.Equal(t, authtypes.NewModuleAddress(types.ModuleName).String(), record.Signer)
+
+	// Nothing new is unlocked within the same day.
+	ctx = ctx.WithBlockHeight(11).WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	requireIntEqual(t, unlockedAt(184), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+
+	// Skipped days are caught up at once.
+	ctx = ctx.WithBlockHeight(12).WithBlockTime(ctx.BlockTime().AddDate(0, 0, 3))
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	requireIntEqual(t, unlockedAt(187), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+
+	next, err := f.keeper.MintSequence.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), next)
+}
+
+func TestBeginBlockerAutoMintRespectsManualMints(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	params.AutoMint = types.AutoMintMode_AUTO_MINT_MODE_BLOCK
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	requireIntEqual(t, unlockedAt(184), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+
+	watermark, err := f.keeper.GetAutoMintWatermark(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, unlockedAt(184).SubRaw(1_000), watermark.Released)
+}
+
+func TestBeginBlockerAutoMintFailureIsDiscarded(t *testing.T) {
+	f := initFixture(t)
+	ctx, params := setupAutoMint(t, f, types.AutoMintMode_AUTO_MINT_MODE_BLOCK)
+
+	// A recipient without a module account makes the distribution fail.
+	params.Recipients = []types.Recipient{types.NewModuleRecipient("unknown", math.LegacyOneDec())}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+	watermark, err := f.keeper.GetAutoMintWatermark(ctx)
+	require.NoError(t, err)
+	require.True(t, watermark.Released.IsZero())
+	has, err := f.keeper.Mints.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestEpochHooksAutoMint(t *testing.T) {
+	f := initFixture(t)
+	ctx, params := setupAutoMint(t, f, types.AutoMintMode_AUTO_MINT_MODE_EPOCH)
+	hooks := f.keeper.Hooks()
+
+	// Per-block minting is disabled in epoch mode.
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).IsZero())
+
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "week", 1))
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).IsZero())
+
+	require.NoError(t, hooks.AfterEpochEnd(ctx, "day", 1))
+	requireIntEqual(t, unlockedAt(184), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+}
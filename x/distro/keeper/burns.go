@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// GetBurnedSupply returns the cumulative amount burned through the module.
+func (k Keeper) GetBurnedSupply(ctx context.Context) (math.Int, error) {
+	burned, err := k.BurnedSupply.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return burned, err
+}
+
+// GetAddressBurned returns the cumulative amount burned from addr.
+func (k Keeper) GetAddressBurned(ctx context.Context, addr sdk.AccAddress) (math.Int, error) {
+	burned, err := k.AddressBurned.Get(ctx, addr)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return burned, err
+}
+
+// burn moves amount coins of the module denom from the from account to the
+// module account, burns them, records them in the burn accounting and emits
+// EventBurn.
+func (k Keeper) burn(ctx sdk.Context, signer string, from sdk.AccAddress, params types.Params, amount math.Int) error {
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, amount))
+	if err := k.bankKeeper.SendCoinsFromAccountToModule(ctx, from, types.ModuleName, coins); err != nil {
+		return err
+	}
+	if err := k.bankKeeper.BurnCoins(ctx, types.ModuleName, coins); err != nil {
+		return err
+	}
+
+	burned, err := k.GetBurnedSupply(ctx)
+	if err != nil {
+		return err
+	}
+	burned = burned.Add(amount)
+	if err := k.BurnedSupply.Set(ctx, burned); err != nil {
+		return err
+	}
+
+	addressBurned, err := k.GetAddressBurned(ctx, from)
+	if err != nil {
+		return err
+	}
+	if err := k.AddressBurned.Set(ctx, from, addressBurned.Add(amount)); err != nil {
+		return err
+	}
+
+	fromStr, err := k.addressCodec.BytesToString(from)
+	if err != nil {
+		return err
+	}
+	return ctx.EventManager().EmitTypedEvent(&types.EventBurn{
+		Signer:       signer,
+		From:         fromStr,
+		Amount:       amount,
+		Denom:        params.Denom,
+		BurnedSupply: burned,
+	})
+}
@@ -0,0 +1,159 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// emissionCurve computes how the max supply unlocks over time.
+type emissionCurve interface {
+	// totalDistributable returns the cumulative distributable cap at the given
+	// time, which is never before the distribution start.
+	totalDistributable(at time.Time) math.Int
+}
+
+var (
+	_ emissionCurve = periodicCurve{}
+	_ emissionCurve = linearCurve{}
+	_ emissionCurve = piecewiseCurve{}
+)
+
+// newEmissionCurve returns the emission curve selected by params, starting at
+// startDate.
+func newEmissionCurve(params types.Params, startDate time.Time) (emissionCurve, error) {
+	curve := params.EmissionCurve
+	switch curve.Type {
+	case types.EmissionCurveType_EMISSION_CURVE_TYPE_HALVING:
+		return periodicCurve{
+			start:          startDate,
+			monthsInPeriod: params.MonthsInHalvingPeriod,
+			cumulative: func(periods uint64) math.Int {
+				total := math.ZeroInt()
+				for period := uint64(1); period <= periods; period++ {
+					limit := halvingPeriodLimit(params.MaxSupply, period)
+					if limit.IsZero() {
+						break
+					}
+					total = total.Add(limit)
+				}
+				return total
+			},
+		}, nil
+	case types.EmissionCurveType_EMISSION_CURVE_TYPE_EXPONENTIAL:
+		maxSupply := params.MaxSupply.ToLegacyDec()
+		return periodicCurve{
+			start:          startDate,
+			monthsInPeriod: params.MonthsInHalvingPeriod,
+			cumulative: func(periods uint64) math.Int {
+				return maxSupply.Mul(math.LegacyOneDec().Sub(curve.DecayRatio.Power(periods))).TruncateInt()
+			},
+		}, nil
+	case types.EmissionCurveType_EMISSION_CURVE_TYPE_LINEAR:
+		return linearCurve{
+			start:     startDate,
+			end:       addMonths(startDate, int(curve.DurationMonths)),
+			maxSupply: params.MaxSupply,
+		}, nil
+	case types.EmissionCurveType_EMISSION_CURVE_TYPE_PIECEWISE:
+		points := make([]piecewisePoint, 0, len(curve.Points)+1)
+		points = append(points, piecewisePoint{at: startDate, cap: math.ZeroInt()})
+		for _, point := range curve.Points {
+			at, err := types.ParseScheduleTime(point.Date)
+			if err != nil {
+				return nil, fmt.Errorf("invalid emission point date: %w", err)
+			}
+			points = append(points, piecewisePoint{at: at, cap: point.CumulativeCap})
+		}
+		return piecewiseCurve{points: points}, nil
+	default:
+		return nil, fmt.Errorf("invalid emission curve type %d", curve.Type)
+	}
+}
+
+// periodicCurve distributes a fixed amount over each period of
+// monthsInPeriod months, pro-rated linearly by time within the period.
+type periodicCurve struct {
+	start          time.Time
+	monthsInPeriod uint64
+	// cumulative returns the amount distributed over the first periods.
+	cumulative func(periods uint64) math.Int
+}
+
+func (c periodicCurve) totalDistributable(at time.Time) math.Int {
+	period, periodStart, nextPeriodStart := periodAt(c.start, c.monthsInPeriod, at)
+
+	before := c.cumulative(period - 1)
+	limit := c.cumulative(period).Sub(before)
+	return before.Add(prorate(limit, periodStart, at, nextPeriodStart))
+}
+
+// linearCurve unlocks maxSupply linearly by time between start and end.
+type linearCurve struct {
+	start, end time.Time
+	maxSupply  math.Int
+}
+
+func (c linearCurve) totalDistributable(at time.Time) math.Int {
+	if !at.Before(c.end) {
+		return c.maxSupply
+	}
+	return prorate(c.maxSupply, c.start, at, c.end)
+}
+
+// piecewisePoint is a point of a piecewiseCurve.
+type piecewisePoint struct {
+	at  time.Time
+	cap math.Int
+}
+
+// piecewiseCurve interpolates the cumulative cap linearly by time between
+// points ordered by time, and stays at the cap of the last point after it.
+type piecewiseCurve struct {
+	points []piecewisePoint
+}
+
+func (c piecewiseCurve) totalDistributable(at time.Time) math.Int {
+	for i := 1; i < len(c.points); i++ {
+		prev, next := c.points[i-1], c.points[i]
+		if !at.Before(next.at) {
+			continue
+		}
+		return prev.cap.Add(prorate(next.cap.Sub(prev.cap), prev.at, at, next.at))
+	}
+	return c.points[len(c.points)-1].cap
+}
+
+// prorate returns the share of amount for the time elapsed from from to at,
+// out of the span from from to to, counted in whole seconds and rounded down.
+func prorate(amount math.Int, from, at, to time.Time) math.Int {
+	span := int64(to.Sub(from) / time.Second)
+	if span <= 0 {
+		return amount
+	}
+	elapsed := int64(at.Sub(from) / time.Second)
+	return amount.Mul(math.NewInt(elapsed)).Quo(math.NewInt(span))
+}
+
+// periodAt returns the 1-based period of monthsInPeriod months that at falls
+// in, with the start of the period and of the next one. at is never before
+// start.
+func periodAt(start time.Time, monthsInPeriod uint64, at time.Time) (uint64, time.Time, time.Time) {
+	period := 1 + uint64(monthsBetween(start, at))/monthsInPeriod
+	periodStart := addMonths(start, int((period-1)*monthsInPeriod))
+	// monthsBetween compares calendar days only, so at may precede the
+	// boundary when start is not at midnight.
+	if period > 1 && at.Before(periodStart) {
+		period--
+		periodStart = addMonths(start, int((period-1)*monthsInPeriod))
+	}
+	return period, periodStart, addMonths(start, int(period*monthsInPeriod))
+}
+
+// daysBetween returns the number of whole days from start to end.
+func daysBetween(start, end time.Time) uint64 {
+	return uint64(end.Sub(start).Hours() / 24)
+}
@@ -0,0 +1,182 @@
+package keeper
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestEmissionCurves(t *testing.T) {
+	d := func(y, m, day int) time.Time {
+		return time.Date(y, time.Month(m), day, 0, 0, 0, 0, time.UTC)
+	}
+	maxSupply := math.NewInt(1_000_000)
+	params := types.NewParams(
+		"",
+		types.DefaultDenom,
+		maxSupply,
+		"2025-01-01",
+		12,
+	)
+
+	tests := []struct {
+		name  string
+		curve types.EmissionCurve
+		date  time.Time
+		want  math.Int
+	}{
+		{"halving start", types.DefaultEmissionCurve(), d(2025, 1, 1), math.ZeroInt()},
+		{"halving mid period", types.DefaultEmissionCurve(), d(2025, 7, 2), math.NewInt(500_000 * 182 / 365)},
+		{"halving second period", types.DefaultEmissionCurve(), d(2026, 1, 1), math.NewInt(500_000)},
+		{"halving exhausted", types.DefaultEmissionCurve(), d(2100, 1, 1), math.NewInt(999_993)},
+		{"linear start", types.NewLinearEmissionCurve(24), d(2025, 1, 1), math.ZeroInt()},
+		{"linear mid", types.NewLinearEmissionCurve(24), d(2026, 1, 1), math.NewInt(1_000_000 * 365 / 730)},
+		{"linear end", types.NewLinearEmissionCurve(24), d(2027, 1, 1), maxSupply},
+		{"linear after end", types.NewLinearEmissionCurve(24), d(2030, 1, 1), maxSupply},
+		{"exponential first period", types.NewExponentialEmissionCurve(math.LegacyNewDecWithPrec(8, 1)), d(2026, 1, 1), math.NewInt(200_000)},
+		{"exponential second period", types.NewExponentialEmissionCurve(math.LegacyNewDecWithPrec(8, 1)), d(2027, 1, 1), math.NewInt(360_000)},
+		{"exponential mid period", types.NewExponentialEmissionCurve(math.LegacyNewDecWithPrec(8, 1)), d(2026, 7, 2), math.NewInt(200_000 + 160_000*182/365)},
+		{
+			"piecewise before first point",
+			types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-01-11", math.NewInt(100)), types.NewEmissionPoint("2025-01-21", math.NewInt(300))),
+			d(2025, 1, 6),
+			math.NewInt(50),
+		},
+		{
+			"piecewise between points",
+			types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-01-11", math.NewInt(100)), types.NewEmissionPoint("2025-01-21", math.NewInt(300))),
+			d(2025, 1, 16),
+			math.NewInt(200),
+		},
+		{
+			"piecewise after last point",
+			types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-01-11", math.NewInt(100)), types.NewEmissionPoint("2025-01-21", math.NewInt(300))),
+			d(2026, 1, 1),
+			math.NewInt(300),
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := params
+			p.EmissionCurve = tc.curve
+			require.NoError(t, p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate))
+
+			state, err := scheduleAt(p, tc.date.Add(12*time.Hour))
+			require.NoError(t, err)
+			require.True(t, tc.want.Equal(state.TotalDistributable), "expected %s, got %s", tc.want, state.TotalDistributable)
+		})
+	}
+}
+
+func TestEmissionCurvePeriodLimit(t *testing.T) {
+	params := types.NewParams("", types.DefaultDenom, math.NewInt(1_000_000), "2025-01-01", 12)
+	blockTime := time.Date(2026, 3, 1, 0, 0, 0, 0, time.UTC)
+
+	// The halving curve keeps its closed-form period limit.
+	state, err := scheduleAt(params, blockTime)
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), state.HalvingPeriod)
+	require.True(t, halvingPeriodLimit(params.MaxSupply, 2).Equal(state.PeriodLimit))
+
+	params.EmissionCurve = types.NewExponentialEmissionCurve(math.LegacyNewDecWithPrec(8, 1))
+	state, err = scheduleAt(params, blockTime)
+	require.NoError(t, err)
+	require.True(t, math.NewInt(160_000).Equal(state.PeriodLimit), state.PeriodLimit.String())
+}
+
+func TestEmissionCurveValidate(t *testing.T) {
+	maxSupply := math.NewInt(1_000)
+	tests := []struct {
+		name  string
+		curve types.EmissionCurve
+		valid bool
+	}{
+		{"halving", types.DefaultEmissionCurve(), true},
+		{"halving with duration", types.EmissionCurve{DurationMonths: 12}, false},
+		{"linear", types.NewLinearEmissionCurve(12), true},
+		{"linear without duration", types.NewLinearEmissionCurve(0), false},
+		{"exponential", types.NewExponentialEmissionCurve(math.LegacyNewDecWithPrec(5, 1)), true},
+		{"exponential ratio of one", types.NewExponentialEmissionCurve(math.LegacyOneDec()), false},
+		{"exponential without ratio", types.NewExponentialEmissionCurve(math.LegacyZeroDec()), false},
+		{"piecewise", types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-02-01", math.NewInt(1_000))), true},
+		{"piecewise without points", types.NewPiecewiseEmissionCurve(), false},
+		{"piecewise at start date", types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-01-01", math.NewInt(10))), false},
+		{
+			"piecewise decreasing cap",
+			types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-02-01", math.NewInt(10)), types.NewEmissionPoint("2025-03-01", math.NewInt(5))),
+			false,
+		},
+		{
+			"piecewise unordered dates",
+			types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-03-01", math.NewInt(10)), types.NewEmissionPoint("2025-02-01", math.NewInt(20))),
+			false,
+		},
+		{"piecewise above max supply", types.NewPiecewiseEmissionCurve(types.NewEmissionPoint("2025-02-01", math.NewInt(1_001))), false},
+		{"unknown type", types.EmissionCurve{Type: types.EmissionCurveType(42)}, false},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.curve.Validate(maxSupply, "2025-01-01")
+			if tc.valid {
+				require.NoError(t, err)
+			} else {
+				require.Error(t, err)
+			}
+		})
+	}
+}
+
+func TestSchedulePrecision(t *testing.T) {
+	// 2025-01-01T12:00:00+02:00 is 10:00 UTC.
+	params := types.NewParams(sample.AccAddress(), types.DefaultDenom, math.NewInt(365*24*3600), "2025-01-01T12:00:00+02:00", 12)
+	params.EmissionCurve = types.NewLinearEmissionCurve(12)
+	require.NoError(t, params.Validate())
+	start := time.Date(2025, 1, 1, 10, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		name      string
+		precision types.SchedulePrecision
+		blockTime time.Time
+		want      math.Int
+	}{
+		{"before start", types.SchedulePrecision_SCHEDULE_PRECISION_DAY, start.Add(-time.Second), math.ZeroInt()},
+		{"day at start", types.SchedulePrecision_SCHEDULE_PRECISION_DAY, start, math.ZeroInt()},
+		{"day within first day", types.SchedulePrecision_SCHEDULE_PRECISION_DAY, start.Add(23 * time.Hour), math.ZeroInt()},
+		{"day after a day", types.SchedulePrecision_SCHEDULE_PRECISION_DAY, start.Add(36 * time.Hour), math.NewInt(24 * 3600)},
+		{"second at start", types.SchedulePrecision_SCHEDULE_PRECISION_SECOND, start, math.ZeroInt()},
+		{"second within first day", types.SchedulePrecision_SCHEDULE_PRECISION_SECOND, start.Add(90 * time.Second), math.NewInt(90)},
+		{"second truncated", types.SchedulePrecision_SCHEDULE_PRECISION_SECOND, start.Add(90*time.Second + 500*time.Millisecond), math.NewInt(90)},
+		{"second after a day", types.SchedulePrecision_SCHEDULE_PRECISION_SECOND, start.Add(36 * time.Hour), math.NewInt(36 * 3600)},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			p := params
+			p.SchedulePrecision = tc.precision
+
+			state, err := scheduleAt(p, tc.blockTime)
+			require.NoError(t, err)
+			require.True(t, tc.want.Equal(state.TotalDistributable), "expected %s, got %s", tc.want, state.TotalDistributable)
+		})
+	}
+}
+
+func TestSchedulePeriodBoundaryWithinDay(t *testing.T) {
+	params := types.NewParams("", types.DefaultDenom, math.NewInt(1_000_000), "2025-01-01T12:00:00Z", 1)
+	params.SchedulePrecision = types.SchedulePrecision_SCHEDULE_PRECISION_SECOND
+
+	// The second period starts at noon: the morning still belongs to the
+	// first one.
+	state, err := scheduleAt(params, time.Date(2025, 2, 1, 11, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), state.HalvingPeriod)
+
+	state, err = scheduleAt(params, time.Date(2025, 2, 1, 12, 0, 0, 0, time.UTC))
+	require.NoError(t, err)
+	require.Equal(t, uint64(2), state.HalvingPeriod)
+	require.True(t, math.NewInt(500_000).Equal(state.TotalDistributable))
+}
@@ -0,0 +1,21 @@
+package keeper
+
+import (
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// erc20Contract returns the hex address of the ERC-20 contract of the native
+// coin token pair of denom, or an empty string when x/erc20 has no enabled
+// native coin pair for it. The ERC-20 balances of a native coin pair are the
+// x/bank balances, so minted coins show up in it without a conversion.
+func (k Keeper) erc20Contract(ctx sdk.Context, denom string) string {
+	if k.erc20Keeper == nil || !k.erc20Keeper.IsERC20Enabled(ctx) {
+		return ""
+	}
+
+	pair, found := k.erc20Keeper.GetTokenPair(ctx, k.erc20Keeper.GetTokenPairID(ctx, denom))
+	if !found || !pair.Enabled || !pair.IsNativeCoin() {
+		return ""
+	}
+	return pair.GetERC20Contract().Hex()
+}
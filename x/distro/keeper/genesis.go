@@ -3,12 +3,108 @@ package keeper
 import (
 	"context"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
 	"github.com/gnodi-network/gnodi/x/distro/types"
 )
 
 // InitGenesis initializes the module's state from a provided genesis state.
 func (k Keeper) InitGenesis(ctx context.Context, genState types.GenesisState) error {
-	return k.Params.Set(ctx, genState.Params)
+	if err := k.Params.Set(ctx, genState.Params); err != nil {
+		return err
+	}
+
+	for _, record := range genState.Mints {
+		if err := k.SetMint(ctx, record); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MintSequence.Set(ctx, genState.MintSequence); err != nil {
+		return err
+	}
+
+	for _, minter := range genState.Minters {
+		addr, err := k.addressCodec.StringToBytes(minter.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.Minters.Set(ctx, addr, minter); err != nil {
+			return err
+		}
+	}
+
+	for _, usage := range genState.MinterUsages {
+		addr, err := k.addressCodec.StringToBytes(usage.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.MinterUsage.Set(ctx, collections.Join(sdk.AccAddress(addr), usage.HalvingPeriod), usage.Amount); err != nil {
+			return err
+		}
+	}
+
+	if err := k.AutoMintWatermark.Set(ctx, genState.AutoMintWatermark); err != nil {
+		return err
+	}
+
+	if !genState.MintedSupply.IsNil() {
+		if err := k.MintedSupply.Set(ctx, genState.MintedSupply); err != nil {
+			return err
+		}
+	}
+
+	if !genState.BurnedSupply.IsNil() {
+		if err := k.BurnedSupply.Set(ctx, genState.BurnedSupply); err != nil {
+			return err
+		}
+	}
+
+	for _, burned := range genState.AddressBurns {
+		addr, err := k.addressCodec.StringToBytes(burned.Address)
+		if err != nil {
+			return err
+		}
+		if err := k.AddressBurned.Set(ctx, addr, burned.Amount); err != nil {
+			return err
+		}
+	}
+
+	for _, update := range genState.PendingParamsUpdates {
+		if err := k.PendingParamsUpdates.Set(ctx, update.Id, update); err != nil {
+			return err
+		}
+	}
+
+	if err := k.ParamsUpdateSequence.Set(ctx, genState.ParamsUpdateSequence); err != nil {
+		return err
+	}
+
+	for _, recent := range genState.RecentMints {
+		if err := k.RecentMints.Set(ctx, recent.Id, recent); err != nil {
+			return err
+		}
+	}
+
+	if genState.PauseStatus.Paused {
+		if err := k.PauseStatus.Set(ctx, genState.PauseStatus); err != nil {
+			return err
+		}
+	}
+
+	for _, proposal := range genState.MintProposals {
+		if err := k.SetMintProposal(ctx, proposal); err != nil {
+			return err
+		}
+	}
+
+	if err := k.MintProposalSequence.Set(ctx, genState.MintProposalSequence); err != nil {
+		return err
+	}
+
+	return nil
 }
 
 // ExportGenesis returns the module's exported genesis.
@@ -21,5 +117,101 @@ func (k Keeper) ExportGenesis(ctx context.Context) (*types.GenesisState, error)
 		return nil, err
 	}
 
+	if err := k.Mints.Walk(ctx, nil, func(_ uint64, record types.MintRecord) (bool, error) {
+		genesis.Mints = append(genesis.Mints, record)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.MintSequence, err = k.MintSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.Minters.Walk(ctx, nil, func(_ sdk.AccAddress, minter types.Minter) (bool, error) {
+		genesis.Minters = append(genesis.Minters, minter)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.MinterUsage.Walk(ctx, nil, func(key collections.Pair[sdk.AccAddress, uint64], amount math.Int) (bool, error) {
+		addr, err := k.addressCodec.BytesToString(key.K1())
+		if err != nil {
+			return true, err
+		}
+		genesis.MinterUsages = append(genesis.MinterUsages, types.MinterUsage{
+			Address:       addr,
+			HalvingPeriod: key.K2(),
+			Amount:        amount,
+		})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.AutoMintWatermark, err = k.GetAutoMintWatermark(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.MintedSupply, err = k.GetMintedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	genesis.BurnedSupply, err = k.GetBurnedSupply(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.AddressBurned.Walk(ctx, nil, func(addr sdk.AccAddress, amount math.Int) (bool, error) {
+		addrStr, err := k.addressCodec.BytesToString(addr)
+		if err != nil {
+			return true, err
+		}
+		genesis.AddressBurns = append(genesis.AddressBurns, types.AddressBurned{Address: addrStr, Amount: amount})
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := k.PendingParamsUpdates.Walk(ctx, nil, func(_ uint64, update types.ScheduledParamsUpdate) (bool, error) {
+		genesis.PendingParamsUpdates = append(genesis.PendingParamsUpdates, update)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.ParamsUpdateSequence, err = k.ParamsUpdateSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.RecentMints.Walk(ctx, nil, func(_ uint64, recent types.RecentMint) (bool, error) {
+		genesis.RecentMints = append(genesis.RecentMints, recent)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.PauseStatus, err = k.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := k.MintProposals.Walk(ctx, nil, func(_ uint64, proposal types.MintProposal) (bool, error) {
+		genesis.MintProposals = append(genesis.MintProposals, proposal)
+		return false, nil
+	}); err != nil {
+		return nil, err
+	}
+
+	genesis.MintProposalSequence, err = k.MintProposalSequence.Peek(ctx)
+	if err != nil {
+		return nil, err
+	}
+
 	return genesis, nil
 }
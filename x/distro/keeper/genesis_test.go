@@ -2,15 +2,90 @@ package keeper_test
 
 import (
 	"testing"
+	"time"
 
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
 	"github.com/gnodi-network/gnodi/x/distro/types"
 
 	"github.com/stretchr/testify/require"
 )
 
 func TestGenesis(t *testing.T) {
+	minter := sample.AccAddress()
 	genesisState := types.GenesisState{
 		Params: types.DefaultParams(),
+		Mints: []types.MintRecord{
+			{
+				Id:          0,
+				Signer:      sample.AccAddress(),
+				Recipient:   sample.AccAddress(),
+				Amount:      math.NewInt(1_000),
+				Denom:       types.DefaultDenom,
+				BlockHeight: 10,
+				BlockTime:   time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+			},
+			{
+				Id:          1,
+				Signer:      sample.AccAddress(),
+				Recipient:   sample.AccAddress(),
+				Amount:      math.NewInt(2_000),
+				Denom:       types.DefaultDenom,
+				BlockHeight: 20,
+				BlockTime:   time.Date(2025, 8, 2, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		MintSequence: 2,
+		Minters: []types.Minter{
+			types.NewMinter(minter, types.NewMinterQuota(math.LegacyNewDecWithPrec(25, 2), math.NewInt(5_000))),
+		},
+		MinterUsages: []types.MinterUsage{
+			{Address: minter, HalvingPeriod: 1, Amount: math.NewInt(3_000)},
+		},
+		AutoMintWatermark: types.AutoMintWatermark{
+			Released:    math.NewInt(5_000),
+			BlockHeight: 30,
+			BlockTime:   time.Date(2025, 8, 3, 0, 0, 0, 0, time.UTC),
+		},
+		MintedSupply: math.NewInt(8_000),
+		BurnedSupply: math.NewInt(600),
+		AddressBurns: []types.AddressBurned{
+			{Address: minter, Amount: math.NewInt(600)},
+		},
+		PendingParamsUpdates: []types.ScheduledParamsUpdate{
+			{
+				Id:               1,
+				Params:           types.Params{MonthsInHalvingPeriod: 24},
+				UpdateMask:       &gogotypes.FieldMask{Paths: []string{"months_in_halving_period"}},
+				ActivationHeight: 100,
+				ScheduledHeight:  40,
+			},
+		},
+		ParamsUpdateSequence: 2,
+		RecentMints: []types.RecentMint{
+			{Id: 1, BlockTime: time.Date(2025, 8, 2, 0, 0, 0, 0, time.UTC), Amount: math.NewInt(2_000)},
+		},
+		PauseStatus: types.PauseStatus{
+			Paused:      true,
+			PausedBy:    minter,
+			Reason:      "incident",
+			BlockHeight: 40,
+			BlockTime:   time.Date(2025, 8, 4, 0, 0, 0, 0, time.UTC),
+		},
+		MintProposals: []types.MintProposal{
+			{
+				Id:           0,
+				Proposer:     minter,
+				Amount:       math.NewInt(500),
+				Approvals:    []string{sample.AccAddress()},
+				SubmitHeight: 35,
+				ExpiresAt:    time.Date(2025, 8, 5, 0, 0, 0, 0, time.UTC),
+			},
+		},
+		MintProposalSequence: 1,
 	}
 
 	f := initFixture(t)
@@ -21,4 +96,24 @@ func TestGenesis(t *testing.T) {
 	require.NotNil(t, got)
 
 	require.EqualExportedValues(t, genesisState.Params, got.Params)
+	require.Equal(t, genesisState.Mints, got.Mints)
+	require.Equal(t, genesisState.MintSequence, got.MintSequence)
+	require.Equal(t, genesisState.Minters, got.Minters)
+	require.Equal(t, genesisState.MinterUsages, got.MinterUsages)
+	require.Equal(t, genesisState.AutoMintWatermark, got.AutoMintWatermark)
+	require.Equal(t, genesisState.MintedSupply, got.MintedSupply)
+	require.Equal(t, genesisState.BurnedSupply, got.BurnedSupply)
+	require.Equal(t, genesisState.AddressBurns, got.AddressBurns)
+	require.Len(t, got.PendingParamsUpdates, 1)
+	require.Equal(t, uint64(24), got.PendingParamsUpdates[0].Params.MonthsInHalvingPeriod)
+	require.Equal(t, genesisState.PendingParamsUpdates[0].UpdateMask, got.PendingParamsUpdates[0].UpdateMask)
+	require.Equal(t, genesisState.PendingParamsUpdates[0].ActivationHeight, got.PendingParamsUpdates[0].ActivationHeight)
+	require.Equal(t, genesisState.ParamsUpdateSequence, got.ParamsUpdateSequence)
+	require.Equal(t, genesisState.RecentMints, got.RecentMints)
+	require.Equal(t, genesisState.PauseStatus, got.PauseStatus)
+	require.Equal(t, genesisState.MintProposals, got.MintProposals)
+	has, err := f.keeper.MintProposalsByExpiry.Has(f.ctx, collections.Join(genesisState.MintProposals[0].ExpiresAt, uint64(0)))
+	require.NoError(t, err)
+	require.True(t, has)
+	require.Equal(t, genesisState.MintProposalSequence, got.MintProposalSequence)
 }
@@ -0,0 +1,40 @@
+package keeper
+
+import (
+	"context"
+
+	epochstypes "github.com/cosmos/cosmos-sdk/x/epochs/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+var _ epochstypes.EpochHooks = Hooks{}
+
+// Hooks implements the x/epochs hooks that drive per-epoch automatic minting.
+type Hooks struct {
+	k Keeper
+}
+
+// Hooks returns the x/epochs hooks of the keeper.
+func (k Keeper) Hooks() Hooks {
+	return Hooks{k}
+}
+
+// AfterEpochEnd mints automatically when per-epoch automatic minting is
+// enabled for epochIdentifier. The x/epochs module discards the state changes
+// of a failing hook without halting the chain.
+func (h Hooks) AfterEpochEnd(ctx context.Context, epochIdentifier string, _ int64) error {
+	params, err := h.k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if params.AutoMint != types.AutoMintMode_AUTO_MINT_MODE_EPOCH || params.AutoMintEpochIdentifier != epochIdentifier {
+		return nil
+	}
+	return h.k.AutoMint(ctx)
+}
+
+// BeforeEpochStart is a no-op.
+func (Hooks) BeforeEpochStart(context.Context, string, int64) error {
+	return nil
+}
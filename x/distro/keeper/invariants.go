@@ -0,0 +1,98 @@
+package keeper
+
+import (
+	"fmt"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// RegisterInvariants registers the distro module invariants.
+func RegisterInvariants(ir sdk.InvariantRegistry, k Keeper) {
+	ir.RegisterRoute(types.ModuleName, "max-supply", MaxSupplyInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "distribution-schedule", DistributionScheduleInvariant(k))
+	ir.RegisterRoute(types.ModuleName, "module-account-balance", ModuleAccountBalanceInvariant(k))
+}
+
+// AllInvariants runs all invariants of the distro module.
+func AllInvariants(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		res, stop := MaxSupplyInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		res, stop = DistributionScheduleInvariant(k)(ctx)
+		if stop {
+			return res, stop
+		}
+		return ModuleAccountBalanceInvariant(k)(ctx)
+	}
+}
+
+// MaxSupplyInvariant checks that the cumulative amount minted by the module
+// does not exceed the max supply. Coins burned through the module are
+// subtracted when params allow burns to reopen the max supply.
+func MaxSupplyInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "max-supply", fmt.Sprintf("unable to get params: %v", err)), true
+		}
+		minted, err := k.GetMintedSupply(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "max-supply", fmt.Sprintf("unable to get minted supply: %v", err)), true
+		}
+		if params.BurnsReopenMaxSupply {
+			burned, err := k.GetBurnedSupply(ctx)
+			if err != nil {
+				return sdk.FormatInvariant(types.ModuleName, "max-supply", fmt.Sprintf("unable to get burned supply: %v", err)), true
+			}
+			minted = math.MaxInt(minted.Sub(burned), math.ZeroInt())
+		}
+
+		broken := minted.GT(params.MaxSupply)
+		return sdk.FormatInvariant(types.ModuleName, "max-supply",
+			fmt.Sprintf("\tminted supply: %s\n\tmax supply: %s\n", minted, params.MaxSupply)), broken
+	}
+}
+
+// DistributionScheduleInvariant checks that the cumulative amount minted by
+// the module, burns included, does not exceed the amount distributable under
+// the schedule at the block time.
+func DistributionScheduleInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "distribution-schedule", fmt.Sprintf("unable to get params: %v", err)), true
+		}
+		schedule, err := scheduleAt(params, ctx.BlockTime())
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "distribution-schedule", fmt.Sprintf("unable to compute the schedule: %v", err)), true
+		}
+		minted, err := k.GetMintedSupply(ctx)
+		if err != nil {
+			return sdk.FormatInvariant(types.ModuleName, "distribution-schedule", fmt.Sprintf("unable to get minted supply: %v", err)), true
+		}
+
+		broken := minted.GT(schedule.TotalDistributable)
+		return sdk.FormatInvariant(types.ModuleName, "distribution-schedule",
+			fmt.Sprintf("\tminted supply: %s\n\ttotal distributable at %s: %s\n",
+				minted, ctx.BlockTime().UTC().Format(time.RFC3339), schedule.TotalDistributable)), broken
+	}
+}
+
+// ModuleAccountBalanceInvariant checks that the module account holds no
+// coins. Minted coins are always forwarded and burned coins always destroyed
+// within the message that moved them into the account.
+func ModuleAccountBalanceInvariant(k Keeper) sdk.Invariant {
+	return func(ctx sdk.Context) (string, bool) {
+		balance := k.bankKeeper.GetAllBalances(ctx, k.accountKeeper.GetModuleAddress(types.ModuleName))
+
+		broken := !balance.IsZero()
+		return sdk.FormatInvariant(types.ModuleName, "module-account-balance",
+			fmt.Sprintf("\tmodule account balance: %s\n", balance)), broken
+	}
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestInvariants(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	_, broken := keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken)
+
+	status, err := qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+	require.NoError(t, err)
+
+	// Minting past the schedule breaks the distribution schedule invariant
+	// only.
+	require.NoError(t, f.keeper.MintedSupply.Set(ctx, status.TotalDistributable.AddRaw(1)))
+	_, broken = keeper.MaxSupplyInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+	msg, broken := keeper.DistributionScheduleInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "distribution-schedule")
+
+	// Minting past the max supply breaks the max supply invariant.
+	require.NoError(t, f.keeper.MintedSupply.Set(ctx, types.DefaultMaxSupply.AddRaw(1)))
+	_, broken = keeper.MaxSupplyInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+	_, broken = keeper.AllInvariants(f.keeper)(ctx)
+	require.True(t, broken)
+}
+
+func TestModuleAccountBalanceInvariant(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	_, broken := keeper.ModuleAccountBalanceInvariant(f.keeper)(ctx)
+	require.False(t, broken)
+
+	// Coins left behind in the module account break the invariant.
+	moduleAddr := authtypes.NewModuleAddress(types.ModuleName).String()
+	f.bankKeeper.balances[moduleAddr] = sdk.NewCoins(sdk.NewInt64Coin(types.DefaultDenom, 1))
+	msg, broken := keeper.ModuleAccountBalanceInvariant(f.keeper)(ctx)
+	require.True(t, broken)
+	require.Contains(t, msg, "1"+types.DefaultDenom)
+}
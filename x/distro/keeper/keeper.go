@@ -1,12 +1,17 @@
 package keeper
 
 import (
+	"bytes"
 	"fmt"
+	"time"
 
 	"cosmossdk.io/collections"
 	"cosmossdk.io/core/address"
 	corestore "cosmossdk.io/core/store"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
 	"github.com/cosmos/cosmos-sdk/codec"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/gnodi-network/gnodi/x/distro/types"
 )
@@ -21,9 +26,45 @@ type Keeper struct {
 
 	Schema collections.Schema
 	Params collections.Item[types.Params]
+	// Mints is the mint ledger, keyed by sequence number.
+	Mints        collections.Map[uint64, types.MintRecord]
+	MintSequence collections.Sequence
+	// MintsBySigner and MintsByHeight index the mint ledger by signer address
+	// and block height respectively.
+	MintsBySigner collections.KeySet[collections.Pair[sdk.AccAddress, uint64]]
+	MintsByHeight collections.KeySet[collections.Pair[int64, uint64]]
+	// Minters is the minter registry, keyed by minter address.
+	Minters collections.Map[sdk.AccAddress, types.Minter]
+	// MinterUsage holds the amount minted per minter and halving period.
+	MinterUsage collections.Map[collections.Pair[sdk.AccAddress, uint64], math.Int]
+	// AutoMintWatermark records how far automatic minting has progressed.
+	AutoMintWatermark collections.Item[types.AutoMintWatermark]
+	// MintedSupply is the cumulative amount minted by the module.
+	MintedSupply collections.Item[math.Int]
+	// BurnedSupply is the cumulative amount burned through the module.
+	BurnedSupply collections.Item[math.Int]
+	// AddressBurned holds the cumulative amount burned per account.
+	AddressBurned collections.Map[sdk.AccAddress, math.Int]
+	// PendingParamsUpdates is the queue of scheduled params updates, keyed by
+	// sequence number.
+	PendingParamsUpdates collections.Map[uint64, types.ScheduledParamsUpdate]
+	ParamsUpdateSequence collections.Sequence
+	// RecentMints holds the MsgMint that count towards the mint rate limits,
+	// keyed by mint ledger id.
+	RecentMints collections.Map[uint64, types.RecentMint]
+	// PauseStatus records whether minting is paused.
+	PauseStatus collections.Item[types.PauseStatus]
+	// MintProposals holds the mint proposals waiting for approvals, keyed by
+	// sequence number.
+	MintProposals        collections.Map[uint64, types.MintProposal]
+	MintProposalSequence collections.Sequence
+	// MintProposalsByExpiry indexes the mint proposals by expiry time.
+	MintProposalsByExpiry collections.KeySet[collections.Pair[time.Time, uint64]]
 
-	bankKeeper    types.BankKeeper
-	accountKeeper types.AccountKeeper
+	bankKeeper         types.BankKeeper
+	accountKeeper      types.AccountKeeper
+	distributionKeeper types.DistributionKeeper
+	erc20Keeper        types.Erc20Keeper
 }
 
 func NewKeeper(
@@ -34,6 +75,8 @@ func NewKeeper(
 
 	bankKeeper types.BankKeeper,
 	accountKeeper types.AccountKeeper,
+	distributionKeeper types.DistributionKeeper,
+	erc20Keeper types.Erc20Keeper,
 ) Keeper {
 	if _, err := addressCodec.BytesToString(authority); err != nil {
 		panic(fmt.Sprintf("invalid authority address %s: %s", authority, err))
@@ -47,9 +90,28 @@ func NewKeeper(
 		addressCodec: addressCodec,
 		authority:    authority,
 
-		bankKeeper:    bankKeeper,
-		accountKeeper: accountKeeper,
-		Params:        collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		bankKeeper:            bankKeeper,
+		accountKeeper:         accountKeeper,
+		distributionKeeper:    distributionKeeper,
+		erc20Keeper:           erc20Keeper,
+		Params:                collections.NewItem(sb, types.ParamsKey, "params", codec.CollValue[types.Params](cdc)),
+		Mints:                 collections.NewMap(sb, types.MintsKey, "mints", collections.Uint64Key, codec.CollValue[types.MintRecord](cdc)),
+		MintSequence:          collections.NewSequence(sb, types.MintSequenceKey, "mint_sequence"),
+		MintsBySigner:         collections.NewKeySet(sb, types.MintsBySignerKey, "mints_by_signer", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key)),
+		MintsByHeight:         collections.NewKeySet(sb, types.MintsByHeightKey, "mints_by_height", collections.PairKeyCodec(collections.Int64Key, collections.Uint64Key)),
+		Minters:               collections.NewMap(sb, types.MintersKey, "minters", sdk.AccAddressKey, codec.CollValue[types.Minter](cdc)),
+		MinterUsage:           collections.NewMap(sb, types.MinterUsageKey, "minter_usage", collections.PairKeyCodec(sdk.AccAddressKey, collections.Uint64Key), sdk.IntValue),
+		AutoMintWatermark:     collections.NewItem(sb, types.AutoMintWatermarkKey, "auto_mint_watermark", codec.CollValue[types.AutoMintWatermark](cdc)),
+		MintedSupply:          collections.NewItem(sb, types.MintedSupplyKey, "minted_supply", sdk.IntValue),
+		BurnedSupply:          collections.NewItem(sb, types.BurnedSupplyKey, "burned_supply", sdk.IntValue),
+		AddressBurned:         collections.NewMap(sb, types.AddressBurnedKey, "address_burned", sdk.AccAddressKey, sdk.IntValue),
+		PendingParamsUpdates:  collections.NewMap(sb, types.PendingParamsUpdatesKey, "pending_params_updates", collections.Uint64Key, codec.CollValue[types.ScheduledParamsUpdate](cdc)),
+		ParamsUpdateSequence:  collections.NewSequence(sb, types.ParamsUpdateSequenceKey, "params_update_sequence"),
+		RecentMints:           collections.NewMap(sb, types.RecentMintsKey, "recent_mints", collections.Uint64Key, codec.CollValue[types.RecentMint](cdc)),
+		PauseStatus:           collections.NewItem(sb, types.PauseStatusKey, "pause_status", codec.CollValue[types.PauseStatus](cdc)),
+		MintProposals:         collections.NewMap(sb, types.MintProposalsKey, "mint_proposals", collections.Uint64Key, codec.CollValue[types.MintProposal](cdc)),
+		MintProposalSequence:  collections.NewSequence(sb, types.MintProposalSequenceKey, "mint_proposal_sequence"),
+		MintProposalsByExpiry: collections.NewKeySet(sb, types.MintProposalsByExpiryKey, "mint_proposals_by_expiry", collections.PairKeyCodec(sdk.TimeKey, collections.Uint64Key)),
 	}
 
 	schema, err := sb.Build()
@@ -65,3 +127,18 @@ func NewKeeper(
 func (k Keeper) GetAuthority() []byte {
 	return k.authority
 }
+
+// validateAuthority returns an error if authority is not the module's
+// authority.
+func (k Keeper) validateAuthority(authority string) error {
+	authorityBytes, err := k.addressCodec.StringToBytes(authority)
+	if err != nil {
+		return errorsmod.Wrap(err, "invalid authority address")
+	}
+
+	if !bytes.Equal(k.authority, authorityBytes) {
+		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.authority)
+		return errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, authority)
+	}
+	return nil
+}
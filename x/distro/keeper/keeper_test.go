@@ -2,16 +2,24 @@ package keeper_test
 
 import (
 	"context"
+	"fmt"
 	"testing"
 
 	"cosmossdk.io/core/address"
+	corestore "cosmossdk.io/core/store"
+	"cosmossdk.io/math"
 	storetypes "cosmossdk.io/store/types"
-	addresscodec "github.com/cosmos/cosmos-sdk/codec/address"
 	"github.com/cosmos/cosmos-sdk/runtime"
 	"github.com/cosmos/cosmos-sdk/testutil"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
 	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+
+	"github.com/stretchr/testify/require"
 
 	"github.com/gnodi-network/gnodi/x/distro/keeper"
 	module "github.com/gnodi-network/gnodi/x/distro/module"
@@ -19,30 +27,215 @@ import (
 )
 
 type fixture struct {
-	ctx          context.Context
-	keeper       keeper.Keeper
-	addressCodec address.Codec
+	ctx           context.Context
+	keeper        keeper.Keeper
+	addressCodec  address.Codec
+	storeService  corestore.KVStoreService
+	bankKeeper    *mockBankKeeper
+	accountKeeper mockAccountKeeper
+	distrKeeper   *mockDistributionKeeper
+	erc20Keeper   *mockErc20Keeper
+}
+
+// mockBankKeeper is an in-memory BankKeeper that tracks supply and balances.
+type mockBankKeeper struct {
+	supply   map[string]math.Int
+	balances map[string]sdk.Coins
+}
+
+func newMockBankKeeper() *mockBankKeeper {
+	return &mockBankKeeper{
+		supply:   make(map[string]math.Int),
+		balances: make(map[string]sdk.Coins),
+	}
+}
+
+func (m *mockBankKeeper) SpendableCoins(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) GetAllBalances(_ context.Context, addr sdk.AccAddress) sdk.Coins {
+	return m.balances[addr.String()]
+}
+
+func (m *mockBankKeeper) MintCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	for _, c := range amt {
+		m.supply[c.Denom] = m.GetSupply(ctx, c.Denom).Amount.Add(c.Amount)
+	}
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	m.balances[addr] = m.balances[addr].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToAccount(_ context.Context, senderModule string, recipientAddr sdk.AccAddress, amt sdk.Coins) error {
+	sender := authtypes.NewModuleAddress(senderModule).String()
+	remaining, hasNeg := m.balances[sender].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient module balance")
+	}
+	m.balances[sender] = remaining
+	m.balances[recipientAddr.String()] = m.balances[recipientAddr.String()].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) SendCoinsFromModuleToModule(ctx context.Context, senderModule, recipientModule string, amt sdk.Coins) error {
+	return m.SendCoinsFromModuleToAccount(ctx, senderModule, authtypes.NewModuleAddress(recipientModule), amt)
+}
+
+func (m *mockBankKeeper) SendCoinsFromAccountToModule(_ context.Context, senderAddr sdk.AccAddress, recipientModule string, amt sdk.Coins) error {
+	remaining, hasNeg := m.balances[senderAddr.String()].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient balance")
+	}
+	m.balances[senderAddr.String()] = remaining
+	recipient := authtypes.NewModuleAddress(recipientModule).String()
+	m.balances[recipient] = m.balances[recipient].Add(amt...)
+	return nil
+}
+
+func (m *mockBankKeeper) BurnCoins(ctx context.Context, moduleName string, amt sdk.Coins) error {
+	addr := authtypes.NewModuleAddress(moduleName).String()
+	remaining, hasNeg := m.balances[addr].SafeSub(amt...)
+	if hasNeg {
+		return fmt.Errorf("insufficient module balance")
+	}
+	m.balances[addr] = remaining
+	for _, c := range amt {
+		m.supply[c.Denom] = m.GetSupply(ctx, c.Denom).Amount.Sub(c.Amount)
+	}
+	return nil
+}
+
+func (m *mockBankKeeper) GetSupply(_ context.Context, denom string) sdk.Coin {
+	amount, ok := m.supply[denom]
+	if !ok {
+		amount = math.ZeroInt()
+	}
+	return sdk.NewCoin(denom, amount)
+}
+
+// BlockedAddr blocks the module accounts known to mockAccountKeeper but the
+// gov one, as the app does.
+func (*mockBankKeeper) BlockedAddr(addr sdk.AccAddress) bool {
+	for _, name := range []string{types.ModuleName, distrtypes.ModuleName, stakingtypes.BondedPoolName} {
+		if addr.Equals(authtypes.NewModuleAddress(name)) {
+			return true
+		}
+	}
+	return false
+}
+
+// mockAccountKeeper knows the module accounts of the distro, distribution
+// and gov modules and the bonded staking pool, and stores the other accounts
+// in memory.
+type mockAccountKeeper struct {
+	accounts map[string]sdk.AccountI
+}
+
+func (m mockAccountKeeper) GetAccount(_ context.Context, addr sdk.AccAddress) sdk.AccountI {
+	return m.accounts[addr.String()]
+}
+
+func (m mockAccountKeeper) NewAccount(_ context.Context, acc sdk.AccountI) sdk.AccountI {
+	if err := acc.SetAccountNumber(uint64(len(m.accounts))); err != nil {
+		panic(err)
+	}
+	return acc
+}
+
+func (m mockAccountKeeper) SetAccount(_ context.Context, acc sdk.AccountI) {
+	m.accounts[acc.GetAddress().String()] = acc
+}
+
+func (mockAccountKeeper) GetModuleAddress(moduleName string) sdk.AccAddress {
+	switch moduleName {
+	case types.ModuleName, distrtypes.ModuleName, types.GovModuleName, stakingtypes.BondedPoolName:
+		return authtypes.NewModuleAddress(moduleName)
+	}
+	return nil
+}
+
+func (m mockAccountKeeper) GetModuleAccount(_ context.Context, moduleName string) sdk.ModuleAccountI {
+	if addr := m.GetModuleAddress(moduleName); addr != nil {
+		return authtypes.NewEmptyModuleAccount(moduleName)
+	}
+	return nil
+}
+
+func (mockAccountKeeper) SetModuleAccount(context.Context, sdk.ModuleAccountI) {}
+
+// mockDistributionKeeper tracks community pool deposits on top of a
+// mockBankKeeper.
+type mockDistributionKeeper struct {
+	bankKeeper    *mockBankKeeper
+	communityPool sdk.Coins
+}
+
+func (m *mockDistributionKeeper) FundCommunityPool(_ context.Context, amount sdk.Coins, sender sdk.AccAddress) error {
+	remaining, hasNeg := m.bankKeeper.balances[sender.String()].SafeSub(amount...)
+	if hasNeg {
+		return fmt.Errorf("insufficient balance")
+	}
+	m.bankKeeper.balances[sender.String()] = remaining
+	pool := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	m.bankKeeper.balances[pool] = m.bankKeeper.balances[pool].Add(amount...)
+	m.communityPool = m.communityPool.Add(amount...)
+	return nil
+}
+
+// mockErc20Keeper holds the x/erc20 token pairs, keyed by denom.
+type mockErc20Keeper struct {
+	disabled bool
+	pairs    map[string]erc20types.TokenPair
+}
+
+func (m *mockErc20Keeper) IsERC20Enabled(sdk.Context) bool {
+	return !m.disabled
+}
+
+func (m *mockErc20Keeper) GetTokenPairID(_ sdk.Context, token string) []byte {
+	if _, ok := m.pairs[token]; !ok {
+		return nil
+	}
+	return []byte(token)
+}
+
+func (m *mockErc20Keeper) GetTokenPair(_ sdk.Context, id []byte) (erc20types.TokenPair, bool) {
+	pair, ok := m.pairs[string(id)]
+	return pair, ok
+}
+
+// requireIntEqual asserts that two math.Int values are numerically equal.
+func requireIntEqual(t *testing.T, expected, actual math.Int, msgAndArgs ...interface{}) {
+	t.Helper()
+	require.True(t, expected.Equal(actual), append([]interface{}{"expected %s, got %s", expected, actual}, msgAndArgs...)...)
 }
 
 func initFixture(t *testing.T) *fixture {
 	t.Helper()
 
 	encCfg := moduletestutil.MakeTestEncodingConfig(module.AppModule{})
-	addressCodec := addresscodec.NewBech32Codec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	addressCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
 	storeKey := storetypes.NewKVStoreKey(types.StoreKey)
 
 	storeService := runtime.NewKVStoreService(storeKey)
 	ctx := testutil.DefaultContextWithDB(t, storeKey, storetypes.NewTransientStoreKey("transient_test")).Ctx
 
 	authority := authtypes.NewModuleAddress(types.GovModuleName)
+	bankKeeper := newMockBankKeeper()
+	accountKeeper := mockAccountKeeper{accounts: make(map[string]sdk.AccountI)}
+	distrKeeper := &mockDistributionKeeper{bankKeeper: bankKeeper}
+	erc20Keeper := &mockErc20Keeper{pairs: make(map[string]erc20types.TokenPair)}
 
 	k := keeper.NewKeeper(
 		storeService,
 		encCfg.Codec,
 		addressCodec,
 		authority,
-		nil,
-		nil,
+		bankKeeper,
+		accountKeeper,
+		distrKeeper,
+		erc20Keeper,
 	)
 
 	// Initialize params
@@ -51,8 +244,13 @@ func initFixture(t *testing.T) *fixture {
 	}
 
 	return &fixture{
-		ctx:          ctx,
-		keeper:       k,
-		addressCodec: addressCodec,
+		ctx:           ctx,
+		keeper:        k,
+		addressCodec:  addressCodec,
+		storeService:  storeService,
+		bankKeeper:    bankKeeper,
+		accountKeeper: accountKeeper,
+		distrKeeper:   distrKeeper,
+		erc20Keeper:   erc20Keeper,
 	}
 }
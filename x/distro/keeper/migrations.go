@@ -0,0 +1,103 @@
+package keeper
+
+import (
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// Migrator is a struct for handling in-place store migrations.
+type Migrator struct {
+	keeper Keeper
+}
+
+// NewMigrator returns a new Migrator.
+func NewMigrator(keeper Keeper) Migrator {
+	return Migrator{keeper: keeper}
+}
+
+// Migrate1to2 moves the single minting address from the module params into
+// the minter registry with an unlimited quota and clears the deprecated field.
+func (m Migrator) Migrate1to2(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	mintingAddress := params.MintingAddress //nolint:staticcheck // migrated out of params
+	if mintingAddress != "" {
+		addr, err := m.keeper.addressCodec.StringToBytes(mintingAddress)
+		if err != nil {
+			return err
+		}
+		if err := m.keeper.Minters.Set(ctx, addr, types.NewMinter(mintingAddress, types.UnlimitedMinterQuota())); err != nil {
+			return err
+		}
+	}
+
+	params.MintingAddress = "" //nolint:staticcheck // migrated out of params
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate2to3 moves the max supply from the uint64 field to the math.Int
+// field that replaces it and clears the deprecated field.
+func (m Migrator) Migrate2to3(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxSupply = math.NewIntFromUint64(params.LegacyMaxSupply) //nolint:staticcheck // migrated to max_supply
+	params.LegacyMaxSupply = 0                                       //nolint:staticcheck // migrated to max_supply
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate3to4 seeds the minted supply counter with the mints recorded in the
+// mint ledger. The bank supply of the denom cannot be used, as it also holds
+// genesis allocations and coins minted by other modules. Mints made before
+// the ledger existed are not recorded, so the upgrade handler running the
+// migration adds them with AddUnrecordedMintedSupply.
+func (m Migrator) Migrate3to4(ctx sdk.Context) error {
+	minted := math.ZeroInt()
+	if err := m.keeper.Mints.Walk(ctx, nil, func(_ uint64, record types.MintRecord) (bool, error) {
+		minted = minted.Add(record.Amount)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+	return m.keeper.MintedSupply.Set(ctx, minted)
+}
+
+// Migrate4to5 sets the max unlock jump param introduced in v5 to zero, which
+// leaves the jump uncapped as before.
+func (m Migrator) Migrate4to5(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxUnlockJump = math.ZeroInt()
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate5to6 selects the halving emission curve, which the module used
+// before the emission curve param was introduced in v6.
+func (m Migrator) Migrate5to6(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.EmissionCurve = types.DefaultEmissionCurve()
+	return m.keeper.Params.Set(ctx, params)
+}
+
+// Migrate6to7 sets the mint rate limit amounts introduced in v7 to zero,
+// which leaves MsgMint unlimited as before.
+func (m Migrator) Migrate6to7(ctx sdk.Context) error {
+	params, err := m.keeper.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params.MaxMintAmount = math.ZeroInt()
+	params.MaxWindowAmount = math.ZeroInt()
+	return m.keeper.Params.Set(ctx, params)
+}
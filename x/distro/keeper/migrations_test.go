@@ -0,0 +1,145 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMigrate1to2(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	mintingAddress := sample.AccAddress()
+	params := types.DefaultParams()
+	params.MintingAddress = mintingAddress //nolint:staticcheck // v1 state
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate1to2(ctx))
+
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.Empty(t, got.MintingAddress) //nolint:staticcheck // cleared by the migration
+
+	addr, err := f.addressCodec.StringToBytes(mintingAddress)
+	require.NoError(t, err)
+	minter, err := f.keeper.Minters.Get(ctx, addr)
+	require.NoError(t, err)
+	require.Equal(t, types.NewMinter(mintingAddress, types.UnlimitedMinterQuota()), minter)
+	require.False(t, minter.Quota.HasShare())
+}
+
+func TestMigrate2to3(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// v2 state stores the max supply in the now deprecated uint64 field.
+	params := types.DefaultParams()
+	params.MaxSupply = math.Int{}
+	params.LegacyMaxSupply = 35_000_000_000_000_000 //nolint:staticcheck // v2 state
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate2to3(ctx))
+
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, types.DefaultMaxSupply, got.MaxSupply)
+	require.Zero(t, got.LegacyMaxSupply) //nolint:staticcheck // cleared by the migration
+}
+
+func TestMigrate3to4(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	// The bank supply of a v3 chain also holds genesis allocations and coins
+	// minted elsewhere, so only the mint ledger counts towards the minted
+	// supply.
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+	f.bankKeeper.supply[types.DefaultDenom] = math.NewInt(3_500)
+	f.bankKeeper.supply["uatom"] = math.NewInt(9_000)
+	for _, amount := range []int64{400, 600} {
+		_, err := f.keeper.AppendMint(ctx, types.MintRecord{Signer: sample.AccAddress(), Amount: math.NewInt(amount), Denom: types.DefaultDenom})
+		require.NoError(t, err)
+	}
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate3to4(ctx))
+
+	minted, err := f.keeper.GetMintedSupply(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(1_000), minted)
+	msg, broken := keeper.AllInvariants(f.keeper)(ctx)
+	require.False(t, broken, msg)
+}
+
+func TestAddUnrecordedMintedSupply(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	require.NoError(t, f.keeper.Params.Set(ctx, types.DefaultParams()))
+	f.bankKeeper.supply[types.DefaultDenom] = math.NewInt(3_500)
+	require.NoError(t, f.keeper.MintedSupply.Set(ctx, math.NewInt(1_000)))
+
+	require.Error(t, f.keeper.AddUnrecordedMintedSupply(ctx, math.Int{}))
+	require.Error(t, f.keeper.AddUnrecordedMintedSupply(ctx, math.NewInt(-1)))
+	require.Error(t, f.keeper.AddUnrecordedMintedSupply(ctx, math.NewInt(2_501)))
+
+	require.NoError(t, f.keeper.AddUnrecordedMintedSupply(ctx, math.NewInt(2_000)))
+	minted, err := f.keeper.GetMintedSupply(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(3_000), minted)
+}
+
+func TestMigrate4to5(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.MaxUnlockJump = math.Int{}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate4to5(ctx))
+
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, got.MaxUnlockJump.IsZero())
+	require.Equal(t, params.MaxSupply, got.MaxSupply)
+}
+
+func TestMigrate5to6(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.EmissionCurve = types.EmissionCurve{}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate5to6(ctx))
+
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, got.EmissionCurve.Equal(types.DefaultEmissionCurve()))
+	require.NoError(t, got.EmissionCurve.Validate(got.MaxSupply, got.DistributionStartDate))
+}
+
+func TestMigrate6to7(t *testing.T) {
+	f := initFixture(t)
+	ctx := sdk.UnwrapSDKContext(f.ctx)
+
+	params := types.DefaultParams()
+	params.MaxMintAmount = math.Int{}
+	params.MaxWindowAmount = math.Int{}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	require.NoError(t, keeper.NewMigrator(f.keeper).Migrate6to7(ctx))
+
+	got, err := f.keeper.Params.Get(ctx)
+	require.NoError(t, err)
+	require.True(t, got.MaxMintAmount.IsZero())
+	require.True(t, got.MaxWindowAmount.IsZero())
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"time"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// mintApprovers returns the mint approvers of params, keyed by address bytes.
+func (k Keeper) mintApprovers(params types.Params) (map[string]struct{}, error) {
+	approvers := make(map[string]struct{}, len(params.MintApprovers))
+	for _, approver := range params.MintApprovers {
+		addr, err := k.addressCodec.StringToBytes(approver)
+		if err != nil {
+			return nil, err
+		}
+		approvers[string(addr)] = struct{}{}
+	}
+	return approvers, nil
+}
+
+// countMintApprovals returns the number of approvals of proposal given by
+// addresses that are still mint approvers.
+func (k Keeper) countMintApprovals(approvers map[string]struct{}, proposal types.MintProposal) (uint32, error) {
+	var count uint32
+	for _, approval := range proposal.Approvals {
+		addr, err := k.addressCodec.StringToBytes(approval)
+		if err != nil {
+			return 0, err
+		}
+		if _, ok := approvers[string(addr)]; ok {
+			count++
+		}
+	}
+	return count, nil
+}
+
+// SetMintProposal stores proposal under its id and indexes it by expiry time.
+func (k Keeper) SetMintProposal(ctx context.Context, proposal types.MintProposal) error {
+	if err := k.MintProposals.Set(ctx, proposal.Id, proposal); err != nil {
+		return err
+	}
+	return k.MintProposalsByExpiry.Set(ctx, collections.Join(proposal.ExpiresAt, proposal.Id))
+}
+
+// removeMintProposal removes proposal and its expiry time index entry.
+func (k Keeper) removeMintProposal(ctx context.Context, proposal types.MintProposal) error {
+	if err := k.MintProposals.Remove(ctx, proposal.Id); err != nil {
+		return err
+	}
+	return k.MintProposalsByExpiry.Remove(ctx, collections.Join(proposal.ExpiresAt, proposal.Id))
+}
+
+// removeExpiredMintProposals drops the mint proposals that expired before
+// reaching the approval threshold. Only the proposals expiring at or before
+// the block time are visited, through the expiry time index.
+func (k Keeper) removeExpiredMintProposals(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	iter, err := k.MintProposalsByExpiry.Iterate(ctx, collections.NewPrefixUntilPairRange[time.Time, uint64](sdkCtx.BlockTime()))
+	if err != nil {
+		return err
+	}
+	expired, err := iter.Keys()
+	if err != nil {
+		return err
+	}
+
+	for _, key := range expired {
+		id := key.K2()
+		proposal, err := k.MintProposals.Get(ctx, id)
+		if err != nil {
+			return err
+		}
+		if err := k.removeMintProposal(ctx, proposal); err != nil {
+			return err
+		}
+		if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventMintProposalExpired{Id: id}); err != nil {
+			return err
+		}
+	}
+	return nil
+}
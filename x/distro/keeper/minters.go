@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// IsAuthorized checks if the sender is a registered minter. The registry is
+// keyed by address bytes, so the EVM hex and bech32 forms of an address are
+// treated as equal.
+func (k Keeper) IsAuthorized(ctx context.Context, signerBytes []byte) (bool, error) {
+	return k.Minters.Has(ctx, sdk.AccAddress(signerBytes))
+}
+
+// GetMinterUsage returns the amount minted by the minter during the given
+// halving period.
+func (k Keeper) GetMinterUsage(ctx context.Context, addr sdk.AccAddress, period uint64) (math.Int, error) {
+	used, err := k.MinterUsage.Get(ctx, collections.Join(addr, period))
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return used, err
+}
+
+// GetMintedTotal returns the amount minted by the minter across all halving
+// periods.
+func (k Keeper) GetMintedTotal(ctx context.Context, addr sdk.AccAddress) (math.Int, error) {
+	total := math.ZeroInt()
+	err := k.MinterUsage.Walk(ctx, collections.NewPrefixedPairRange[sdk.AccAddress, uint64](addr), func(_ collections.Pair[sdk.AccAddress, uint64], used math.Int) (bool, error) {
+		total = total.Add(used)
+		return false, nil
+	})
+	return total, err
+}
+
+// checkMinterQuota returns an error if minting amount on behalf of addr would
+// exceed the minter quota under the given schedule state.
+func (k Keeper) checkMinterQuota(ctx context.Context, addr sdk.AccAddress, schedule scheduleState, amount math.Int) error {
+	minter, err := k.Minters.Get(ctx, addr)
+	if err != nil {
+		return err
+	}
+
+	if minter.Quota.HasPeriodLimit() {
+		used, err := k.GetMinterUsage(ctx, addr, schedule.HalvingPeriod)
+		if err != nil {
+			return err
+		}
+		if used.Add(amount).GT(minter.Quota.PeriodLimit) {
+			return errorsmod.Wrapf(types.ErrMinterQuotaExceeded, "period limit of %s exceeded: already minted %s in period %d", minter.Quota.PeriodLimit, used, schedule.HalvingPeriod)
+		}
+	}
+
+	if minter.Quota.HasShare() {
+		total, err := k.GetMintedTotal(ctx, addr)
+		if err != nil {
+			return err
+		}
+		allowed := math.LegacyNewDecFromInt(schedule.TotalDistributable).Mul(minter.Quota.Share).TruncateInt()
+		if total.Add(amount).GT(allowed) {
+			return errorsmod.Wrapf(types.ErrMinterQuotaExceeded, "share of %s exceeded: already minted %s of %s allowed", minter.Quota.Share, total, allowed)
+		}
+	}
+
+	return nil
+}
+
+// addMinterUsage adds amount to the usage of the minter in the given halving
+// period.
+func (k Keeper) addMinterUsage(ctx context.Context, addr sdk.AccAddress, period uint64, amount math.Int) error {
+	used, err := k.GetMinterUsage(ctx, addr, period)
+	if err != nil {
+		return err
+	}
+	return k.MinterUsage.Set(ctx, collections.Join(addr, period), used.Add(amount))
+}
@@ -0,0 +1,44 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// AppendMint assigns the next sequence number to record and stores it in the
+// mint ledger. It returns the assigned id.
+func (k Keeper) AppendMint(ctx context.Context, record types.MintRecord) (uint64, error) {
+	id, err := k.MintSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	record.Id = id
+
+	if err := k.SetMint(ctx, record); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// SetMint stores record in the mint ledger under its id and updates the
+// signer and block height indexes.
+func (k Keeper) SetMint(ctx context.Context, record types.MintRecord) error {
+	signer, err := k.addressCodec.StringToBytes(record.Signer)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid signer address '%s'", record.Signer)
+	}
+
+	if err := k.Mints.Set(ctx, record.Id, record); err != nil {
+		return err
+	}
+	if err := k.MintsBySigner.Set(ctx, collections.Join(sdk.AccAddress(signer), record.Id)); err != nil {
+		return err
+	}
+	return k.MintsByHeight.Set(ctx, collections.Join(record.BlockHeight, record.Id))
+}
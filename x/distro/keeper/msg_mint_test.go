@@ -0,0 +1,542 @@
+package keeper_test
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	erc20types "github.com/cosmos/evm/x/erc20/types"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// setupMint configures the fixture with a receiver and a registered minter
+// without quota and returns a context whose block time lies inside the first
+// halving period together with the minter address.
+func setupMint(t *testing.T, f *fixture) (sdk.Context, types.Params, string) {
+	t.Helper()
+
+	params := types.NewParams(
+		sample.AccAddress(),
+		types.DefaultDenom,
+		types.DefaultMaxSupply,
+		types.DefaultDistributionStartDate,
+		types.DefaultMonthsInHalvingPeriod,
+	)
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	minter := addMinter(t, f, types.UnlimitedMinterQuota())
+
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2026, 1, 22, 12, 0, 0, 0, time.UTC))
+	return ctx, params, minter
+}
+
+// addMinter registers a new sample minter with the given quota.
+func addMinter(t *testing.T, f *fixture, quota types.MinterQuota) string {
+	t.Helper()
+
+	minter := sample.AccAddress()
+	addr, err := f.addressCodec.StringToBytes(minter)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.Minters.Set(f.ctx, addr, types.NewMinter(minter, quota)))
+	return minter
+}
+
+func TestMsgMint(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgMint
+		expErr    bool
+		expErrMsg string
+	}{
+		{
+			name:      "unauthorized signer",
+			input:     types.NewMsgMint(math.NewInt(1_000), sample.AccAddress()),
+			expErr:    true,
+			expErrMsg: "unauthorized sender",
+		},
+		{
+			name:      "zero amount",
+			input:     types.NewMsgMint(math.ZeroInt(), minter),
+			expErr:    true,
+			expErrMsg: "amount must be positive",
+		},
+		{
+			name:      "exceeds distributable limit",
+			input:     types.NewMsgMint(types.DefaultMaxSupply.QuoRaw(2), minter),
+			expErr:    true,
+			expErrMsg: "amount exceeds total distributable limit",
+		},
+		{
+			name:   "all good",
+			input:  types.NewMsgMint(math.NewInt(1_000), minter),
+			expErr: false,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			_, err := ms.Mint(ctx, tc.input)
+
+			if tc.expErr {
+				require.Error(t, err)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgMintEmitsEvent(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	res, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	var found bool
+	for _, e := range ctx.EventManager().Events() {
+		if e.Type != "gnodi.distro.v1.EventMint" {
+			continue
+		}
+		found = true
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(e))
+		require.NoError(t, err)
+		event, ok := msg.(*types.EventMint)
+		require.True(t, ok)
+		require.Equal(t, minter, event.Signer)
+		require.Equal(t, params.ReceivingAddress, event.Recipient)
+		requireIntEqual(t, math.NewInt(1_000), event.Amount)
+		require.Equal(t, params.Denom, event.Denom)
+		require.Equal(t, uint64(1), event.HalvingPeriod)
+		requireIntEqual(t, math.NewInt(1_000), event.SupplyAfter)
+		require.True(t, event.TotalDistributable.IsPositive())
+		require.Equal(t, res.Id, event.Id)
+	}
+	require.True(t, found, "EventMint not emitted")
+}
+
+func TestMsgMintRecordsLedger(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	ctx = ctx.WithBlockHeight(42)
+
+	for i := uint64(0); i < 3; i++ {
+		amount := math.NewIntFromUint64(1_000 + i)
+		res, err := ms.Mint(ctx, types.NewMsgMint(amount, minter))
+		require.NoError(t, err)
+		require.Equal(t, i, res.Id)
+
+		record, err := f.keeper.Mints.Get(ctx, res.Id)
+		require.NoError(t, err)
+		require.Equal(t, i, record.Id)
+		require.Equal(t, minter, record.Signer)
+		require.Equal(t, params.ReceivingAddress, record.Recipient)
+		requireIntEqual(t, amount, record.Amount)
+		require.Equal(t, params.Denom, record.Denom)
+		require.Equal(t, int64(42), record.BlockHeight)
+		require.Equal(t, ctx.BlockTime(), record.BlockTime)
+		require.Len(t, record.Payouts, 1)
+		require.Equal(t, params.ReceivingAddress, record.Payouts[0].Address)
+		requireIntEqual(t, amount, record.Payouts[0].Amount)
+	}
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(types.DefaultMaxSupply.QuoRaw(2), minter))
+	require.Error(t, err)
+
+	next, err := f.keeper.MintSequence.Peek(ctx)
+	require.NoError(t, err)
+	require.Equal(t, uint64(3), next)
+}
+
+func TestMsgMintMinterQuota(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, _ := setupMint(t, f)
+
+	// The cumulative distributable cap at the block time of setupMint.
+	totalDistributable := types.DefaultMaxSupply.QuoRaw(2).MulRaw(184).QuoRaw(365)
+
+	testCases := []struct {
+		name      string
+		quota     types.MinterQuota
+		mints     []math.Int
+		expErrMsg string
+	}{
+		{
+			name:  "unlimited quota",
+			quota: types.UnlimitedMinterQuota(),
+			mints: []math.Int{math.NewInt(1_000), math.NewInt(1_000)},
+		},
+		{
+			name:  "within period limit",
+			quota: types.NewMinterQuota(math.LegacyZeroDec(), math.NewInt(2_000)),
+			mints: []math.Int{math.NewInt(1_000), math.NewInt(1_000)},
+		},
+		{
+			name:      "exceeds period limit",
+			quota:     types.NewMinterQuota(math.LegacyZeroDec(), math.NewInt(1_500)),
+			mints:     []math.Int{math.NewInt(1_000), math.NewInt(1_000)},
+			expErrMsg: "period limit of 1500 exceeded",
+		},
+		{
+			name:  "within share",
+			quota: types.NewMinterQuota(math.LegacyNewDecWithPrec(1, 1), math.ZeroInt()),
+			mints: []math.Int{totalDistributable.QuoRaw(10)},
+		},
+		{
+			name:      "exceeds share",
+			quota:     types.NewMinterQuota(math.LegacyNewDecWithPrec(1, 1), math.ZeroInt()),
+			mints:     []math.Int{totalDistributable.QuoRaw(10), math.OneInt()},
+			expErrMsg: "share of 0.100000000000000000 exceeded",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			minter := addMinter(t, f, tc.quota)
+
+			var err error
+			for _, amount := range tc.mints {
+				if _, err = ms.Mint(ctx, types.NewMsgMint(amount, minter)); err != nil {
+					break
+				}
+			}
+
+			if tc.expErrMsg != "" {
+				require.ErrorIs(t, err, types.ErrMinterQuotaExceeded)
+				require.Contains(t, err.Error(), tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestMsgMintSplitsRecipients(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	treasury := sample.AccAddress()
+	params.Recipients = []types.Recipient{
+		types.NewRecipient(treasury, math.LegacyNewDecWithPrec(60, 2)),
+		types.NewModuleRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(25, 2)),
+		types.NewModuleRecipient(types.GovModuleName, math.LegacyNewDecWithPrec(15, 2)),
+	}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	res, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(999), minter))
+	require.NoError(t, err)
+
+	communityPool := authtypes.NewModuleAddress(distrtypes.ModuleName).String()
+	gov := authtypes.NewModuleAddress(types.GovModuleName).String()
+	expPayouts := []types.Payout{
+		{Address: treasury, Amount: math.NewInt(599)},
+		{Address: communityPool, Amount: math.NewInt(249)},
+		{Address: gov, Amount: math.NewInt(149)},
+		{Address: params.ReceivingAddress, Amount: math.NewInt(2)},
+	}
+
+	record, err := f.keeper.Mints.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Len(t, record.Payouts, len(expPayouts))
+	for i, payout := range expPayouts {
+		require.Equal(t, payout.Address, record.Payouts[i].Address)
+		requireIntEqual(t, payout.Amount, record.Payouts[i].Amount, payout.Address)
+		requireIntEqual(t, payout.Amount, f.bankKeeper.balances[payout.Address].AmountOf(params.Denom), payout.Address)
+	}
+	require.Equal(t, math.NewInt(249), f.distrKeeper.communityPool.AmountOf(params.Denom))
+	require.True(t, f.bankKeeper.balances[authtypes.NewModuleAddress(types.ModuleName).String()].IsZero())
+}
+
+func TestMsgMintToDestination(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	destination := sample.AccAddress()
+	params.Recipients = []types.Recipient{types.NewRecipient(sample.AccAddress(), math.LegacyOneDec())}
+	params.MintDestinations = []string{destination}
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter).WithRecipient(sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrRecipientNotAllowed)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter).WithReference(strings.Repeat("x", types.MaxMintReferenceLength+1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter).WithRecipient(destination).WithReference("DISB-42"))
+	require.NoError(t, err)
+
+	// The whole mint goes to the destination, bypassing the weighted
+	// recipients.
+	requireIntEqual(t, math.NewInt(1_000), f.bankKeeper.balances[destination].AmountOf(params.Denom))
+	record, err := f.keeper.Mints.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, destination, record.Recipient)
+	require.Equal(t, "DISB-42", record.Reference)
+	require.Len(t, record.Payouts, 1)
+	require.Equal(t, destination, record.Payouts[0].Address)
+
+	events := ctx.EventManager().Events()
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	require.NoError(t, err)
+	event, ok := msg.(*types.EventMint)
+	require.True(t, ok)
+	require.Equal(t, destination, event.Recipient)
+	require.Equal(t, "DISB-42", event.Reference)
+}
+
+func TestMsgMintErc20Contract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	requireErc20Contract := func(expected string) {
+		t.Helper()
+		ctx := ctx.WithEventManager(sdk.NewEventManager())
+		res, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+		require.NoError(t, err)
+
+		record, err := f.keeper.Mints.Get(ctx, res.Id)
+		require.NoError(t, err)
+		require.Equal(t, expected, record.Erc20Contract)
+
+		events := ctx.EventManager().Events()
+		msg, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+		require.NoError(t, err)
+		event, ok := msg.(*types.EventMint)
+		require.True(t, ok)
+		require.Equal(t, expected, event.Erc20Contract)
+	}
+
+	// Mints never fail for the lack of a token pair, they just name none.
+	requireErc20Contract("")
+
+	pair := erc20types.NewTokenPair(common.HexToAddress("0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"), params.Denom, erc20types.OWNER_MODULE)
+	pair.Enabled = false
+	f.erc20Keeper.pairs[params.Denom] = pair
+	requireErc20Contract("")
+
+	pair.Enabled = true
+	f.erc20Keeper.pairs[params.Denom] = pair
+	f.erc20Keeper.disabled = true
+	requireErc20Contract("")
+
+	// A pair of an ERC-20 contract converted to coins has balances of its
+	// own, which the mint does not credit.
+	f.erc20Keeper.disabled = false
+	f.erc20Keeper.pairs[params.Denom] = erc20types.NewTokenPair(common.HexToAddress("0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"), params.Denom, erc20types.OWNER_EXTERNAL)
+	requireErc20Contract("")
+
+	f.erc20Keeper.pairs[params.Denom] = pair
+	requireErc20Contract(pair.Erc20Address)
+
+	// The native coin pair reads x/bank balances, so the coins land where
+	// they always do.
+	requireIntEqual(t, math.NewInt(5_000), f.bankKeeper.balances[params.ReceivingAddress].AmountOf(params.Denom))
+}
+
+func TestCosmosMintsErc20Contract(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	pair := erc20types.NewTokenPair(common.HexToAddress("0xD4949664cD82660AaE99bEdc034a0deA8A0bd517"), params.Denom, erc20types.OWNER_MODULE)
+	f.erc20Keeper.pairs[params.Denom] = pair
+	grantee := sample.AccAddress()
+	params.MintDestinations = []string{grantee}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	// Mints made by Cosmos transactions other than MsgMint name the token
+	// pair too, in the ledger and in EventMint.
+	requireErc20Contract := func(ctx sdk.Context, id uint64) {
+		t.Helper()
+		record, err := f.keeper.Mints.Get(ctx, id)
+		require.NoError(t, err)
+		require.Equal(t, pair.Erc20Address, record.Erc20Contract)
+
+		var found bool
+		for _, event := range ctx.EventManager().Events() {
+			msg, err := sdk.ParseTypedEvent(abci.Event(event))
+			if err != nil {
+				continue
+			}
+			if eventMint, ok := msg.(*types.EventMint); ok {
+				require.Equal(t, pair.Erc20Address, eventMint.Erc20Contract)
+				found = true
+			}
+		}
+		require.True(t, found)
+	}
+
+	vestingCtx := ctx.WithEventManager(sdk.NewEventManager())
+	vested, err := ms.MintVesting(vestingCtx, types.NewMsgMintDelayedVesting(minter, grantee, math.NewInt(1_000), ctx.BlockTime().Unix()+3600))
+	require.NoError(t, err)
+	requireErc20Contract(vestingCtx, vested.Id)
+
+	approvers := setupMintApprovals(t, f, params)
+	proposed, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(500)))
+	require.NoError(t, err)
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], proposed.Id))
+	require.NoError(t, err)
+	approveCtx := ctx.WithEventManager(sdk.NewEventManager())
+	approved, err := ms.ApproveMint(approveCtx, types.NewMsgApproveMint(approvers[1], proposed.Id))
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+	requireErc20Contract(approveCtx, approved.MintId)
+
+	requireIntEqual(t, math.NewInt(1_000), f.bankKeeper.balances[grantee].AmountOf(params.Denom))
+	requireIntEqual(t, math.NewInt(500), f.bankKeeper.balances[params.ReceivingAddress].AmountOf(params.Denom))
+}
+
+func TestMsgMintSupplyBasis(t *testing.T) {
+	testCases := []struct {
+		name      string
+		basis     types.SupplyBasis
+		expErrMsg string
+	}{
+		{
+			name:      "bank supply counts tokens minted elsewhere",
+			basis:     types.SupplyBasis_SUPPLY_BASIS_BANK,
+			expErrMsg: "max supply exceeded",
+		},
+		{
+			name:  "distro supply only counts the module's mints",
+			basis: types.SupplyBasis_SUPPLY_BASIS_DISTRO,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			ctx, params, minter := setupMint(t, f)
+
+			params.MaxSupplyBasis = tc.basis
+			require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+			// Tokens minted by another module, e.g. x/mint inflation, leave
+			// less than the amount below under the max supply.
+			external := params.MaxSupply.SubRaw(500)
+			require.NoError(t, f.bankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin(params.Denom, external))))
+
+			_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+			minted, mintedErr := f.keeper.GetMintedSupply(ctx)
+			require.NoError(t, mintedErr)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				require.True(t, minted.IsZero())
+				return
+			}
+			require.NoError(t, err)
+			requireIntEqual(t, math.NewInt(1_000), minted)
+		})
+	}
+}
+
+func TestMsgMintScheduleCapsMintedSupply(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	// Supply minted outside the module does not use up the schedule.
+	totalDistributable := types.DefaultMaxSupply.QuoRaw(2).MulRaw(184).QuoRaw(365)
+	require.NoError(t, f.bankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin(params.Denom, totalDistributable))))
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(totalDistributable, minter))
+	require.NoError(t, err)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.OneInt(), minter))
+	require.ErrorContains(t, err, "amount exceeds total distributable limit")
+
+	minted, err := f.keeper.GetMintedSupply(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, totalDistributable, minted)
+}
+
+func TestMsgMintRateLimits(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	params.MaxMintAmount = math.NewInt(1_000)
+	params.MintWindow = 24 * time.Hour
+	params.MaxWindowAmount = math.NewInt(2_500)
+	params.MinMintInterval = time.Minute
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	mint := func(ctx sdk.Context, amount int64) error {
+		_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(amount), minter))
+		return err
+	}
+
+	require.ErrorIs(t, mint(ctx, 1_001), types.ErrMintAmountTooLarge)
+	require.NoError(t, mint(ctx, 1_000))
+
+	// A second mint within the min interval is rejected.
+	require.ErrorIs(t, mint(ctx.WithBlockTime(ctx.BlockTime().Add(59*time.Second)), 1), types.ErrMintTooFrequent)
+
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Minute))
+	require.NoError(t, mint(ctx, 1_000))
+
+	// The window holds 2,000 out of 2,500.
+	ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour))
+	require.ErrorIs(t, mint(ctx, 501), types.ErrMintWindowExceeded)
+	require.NoError(t, mint(ctx, 500))
+
+	// The first mint leaves the window 24h after it was made.
+	first := ctx.BlockTime().Add(-time.Hour - time.Minute)
+	ctx = ctx.WithBlockTime(first.Add(24*time.Hour - time.Second))
+	require.ErrorIs(t, mint(ctx, 1), types.ErrMintWindowExceeded)
+	ctx = ctx.WithBlockTime(first.Add(24 * time.Hour))
+	require.NoError(t, mint(ctx, 1_000))
+
+	// Mints outside the window are pruned.
+	var ids []uint64
+	require.NoError(t, f.keeper.RecentMints.Walk(ctx, nil, func(id uint64, _ types.RecentMint) (bool, error) {
+		ids = append(ids, id)
+		return false, nil
+	}))
+	require.Equal(t, []uint64{1, 2, 3}, ids)
+}
+
+func TestMsgMintRateLimitsDisabled(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	for range 3 {
+		_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000_000), minter))
+		require.NoError(t, err)
+	}
+
+	// Nothing is tracked while the limits are disabled.
+	has, err := f.keeper.RecentMints.Has(ctx, 0)
+	require.NoError(t, err)
+	require.False(t, has)
+}
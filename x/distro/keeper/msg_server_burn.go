@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) Burn(ctx context.Context, msg *types.MsgBurn) (*types.MsgBurnResponse, error) {
+	signerBytes, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+
+	if err := k.burn(sdk.UnwrapSDKContext(ctx), msg.Signer, signerBytes, params, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnResponse{}, nil
+}
+
+func (k msgServer) BurnFromTreasury(ctx context.Context, msg *types.MsgBurnFromTreasury) (*types.MsgBurnFromTreasuryResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+	if params.ReceivingAddress == "" {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "receiving address not configured")
+	}
+
+	treasury, err := k.addressCodec.StringToBytes(params.ReceivingAddress)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid receiving address '%s'", params.ReceivingAddress)
+	}
+
+	if err := k.burn(sdk.UnwrapSDKContext(ctx), msg.Authority, treasury, params, msg.Amount); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgBurnFromTreasuryResponse{}, nil
+}
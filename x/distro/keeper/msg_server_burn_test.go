@@ -0,0 +1,214 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgBurn(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+	holder := params.ReceivingAddress
+
+	testCases := []struct {
+		name      string
+		input     *types.MsgBurn
+		expErrMsg string
+	}{
+		{
+			name:      "invalid signer",
+			input:     types.NewMsgBurn("invalid", math.NewInt(100)),
+			expErrMsg: "invalid signer address",
+		},
+		{
+			name:      "zero amount",
+			input:     types.NewMsgBurn(holder, math.ZeroInt()),
+			expErrMsg: "amount must be positive",
+		},
+		{
+			name:      "insufficient funds",
+			input:     types.NewMsgBurn(sample.AccAddress(), math.NewInt(100)),
+			expErrMsg: "insufficient balance",
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgBurn(holder, math.NewInt(400)),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			_, err := ms.Burn(ctx, tc.input)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			events := ctx.EventManager().Events()
+			require.NotEmpty(t, events)
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+			require.NoError(t, err)
+			event, ok := msg.(*types.EventBurn)
+			require.True(t, ok)
+			require.Equal(t, holder, event.Signer)
+			require.Equal(t, holder, event.From)
+			requireIntEqual(t, math.NewInt(400), event.Amount)
+			require.Equal(t, params.Denom, event.Denom)
+			requireIntEqual(t, math.NewInt(400), event.BurnedSupply)
+		})
+	}
+
+	requireIntEqual(t, math.NewInt(600), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+	requireIntEqual(t, math.NewInt(600), f.bankKeeper.balances[holder].AmountOf(params.Denom))
+
+	burned, err := f.keeper.GetBurnedSupply(ctx)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(400), burned)
+
+	addr, err := f.addressCodec.StringToBytes(holder)
+	require.NoError(t, err)
+	addressBurned, err := f.keeper.GetAddressBurned(ctx, addr)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(400), addressBurned)
+}
+
+func TestMsgBurnFromTreasury(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	_, err = ms.BurnFromTreasury(ctx, types.NewMsgBurnFromTreasury(sample.AccAddress(), math.NewInt(100)))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.BurnFromTreasury(ctx, types.NewMsgBurnFromTreasury(authorityStr, math.NewInt(-1)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.BurnFromTreasury(ctx, types.NewMsgBurnFromTreasury(authorityStr, math.NewInt(2_000)))
+	require.ErrorContains(t, err, "insufficient balance")
+
+	_, err = ms.BurnFromTreasury(ctx, types.NewMsgBurnFromTreasury(authorityStr, math.NewInt(1_000)))
+	require.NoError(t, err)
+	require.True(t, f.bankKeeper.balances[params.ReceivingAddress].IsZero())
+
+	addr, err := f.addressCodec.StringToBytes(params.ReceivingAddress)
+	require.NoError(t, err)
+	addressBurned, err := f.keeper.GetAddressBurned(ctx, addr)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(1_000), addressBurned)
+
+	params.ReceivingAddress = ""
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+	_, err = ms.BurnFromTreasury(ctx, types.NewMsgBurnFromTreasury(authorityStr, math.NewInt(100)))
+	require.ErrorContains(t, err, "receiving address not configured")
+}
+
+func TestBurnsReopenMaxSupply(t *testing.T) {
+	testCases := []struct {
+		name      string
+		reopen    bool
+		expErrMsg string
+	}{
+		{
+			name:      "burns keep counting",
+			expErrMsg: "max supply exceeded",
+		},
+		{
+			name:   "burns reopen",
+			reopen: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			ctx, params, minter := setupMint(t, f)
+
+			params.BurnsReopenMaxSupply = tc.reopen
+			require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+			// Tokens minted elsewhere fill the bank supply up to the max
+			// supply once the module minted 1,000.
+			external := params.MaxSupply.SubRaw(1_000)
+			require.NoError(t, f.bankKeeper.MintCoins(ctx, "mint", sdk.NewCoins(sdk.NewCoin(params.Denom, external))))
+
+			_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+			require.NoError(t, err)
+			_, err = ms.Burn(ctx, types.NewMsgBurn(params.ReceivingAddress, math.NewInt(500)))
+			require.NoError(t, err)
+
+			_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(500), minter))
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+		})
+	}
+}
+
+func TestBurnsDoNotReopenSchedule(t *testing.T) {
+	for _, basis := range []types.SupplyBasis{types.SupplyBasis_SUPPLY_BASIS_BANK, types.SupplyBasis_SUPPLY_BASIS_DISTRO} {
+		t.Run(basis.String(), func(t *testing.T) {
+			f := initFixture(t)
+			ms := keeper.NewMsgServerImpl(f.keeper)
+			qs := keeper.NewQueryServerImpl(f.keeper)
+			ctx, params, minter := setupMint(t, f)
+
+			params.MaxSupplyBasis = basis
+			params.BurnsReopenMaxSupply = true
+			params.SchedulePrecision = types.SchedulePrecision_SCHEDULE_PRECISION_SECOND
+			require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+			// The module mints everything distributable at the block time.
+			status, err := qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+			require.NoError(t, err)
+			_, err = ms.Mint(ctx, types.NewMsgMint(status.TotalDistributable, minter))
+			require.NoError(t, err)
+
+			// A second later the curve unlocks delta, less than what holders
+			// burn in the meantime.
+			ctx = ctx.WithBlockTime(ctx.BlockTime().Add(time.Second))
+			next, err := qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+			require.NoError(t, err)
+			delta := next.TotalDistributable.Sub(status.TotalDistributable)
+			require.True(t, delta.IsPositive())
+			_, err = ms.Burn(ctx, types.NewMsgBurn(params.ReceivingAddress, delta.MulRaw(2)))
+			require.NoError(t, err)
+
+			next, err = qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+			require.NoError(t, err)
+			requireIntEqual(t, delta, next.Mintable)
+
+			_, err = ms.Mint(ctx, types.NewMsgMint(delta.AddRaw(1), minter))
+			require.ErrorContains(t, err, "amount exceeds total distributable limit")
+			_, err = ms.Mint(ctx, types.NewMsgMint(delta, minter))
+			require.NoError(t, err)
+
+			msg, broken := keeper.AllInvariants(f.keeper)(ctx)
+			require.False(t, broken, msg)
+		})
+	}
+}
@@ -2,6 +2,7 @@ package keeper
 
 import (
 	"context"
+	"math/big"
 	"time"
 
 	errorsmod "cosmossdk.io/errors"
@@ -13,118 +14,205 @@ import (
 )
 
 func (k msgServer) Mint(goCtx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
-	ctx := sdk.UnwrapSDKContext(goCtx)
-
-	signerBytes, err := k.addressCodec.StringToBytes(msg.Signer)
+	params, err := k.Params.Get(goCtx)
 	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid signer address")
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+	if params.MintApprovalThreshold > 0 {
+		return nil, errorsmod.Wrap(types.ErrMintApprovalRequired, "mints must be proposed with MsgProposeMint")
 	}
 
-	params, err := k.Params.Get(goCtx)
+	id, err := k.mint(sdk.UnwrapSDKContext(goCtx), msg.Signer, msg.Amount, msg.Recipient, msg.Reference)
 	if err != nil {
-		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+		return nil, err
 	}
 
-	if !k.IsAuthorized(params, signerBytes) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	return &types.MsgMintResponse{Id: id}, nil
+}
+
+// mint mints amount coins on behalf of the registered minter signer, after
+// checking the pause status, the rate limits, the max supply, the
+// distribution schedule and the quota of the minter. The coins go to
+// recipient when it is set, and are distributed according to params
+// otherwise. It returns the id of the mint ledger record.
+func (k Keeper) mint(ctx sdk.Context, signer string, amount math.Int, recipient, reference string) (uint64, error) {
+	signerBytes, err := k.addressCodec.StringToBytes(signer)
+	if err != nil {
+		return 0, errorsmod.Wrap(err, "invalid signer address")
 	}
 
-	currentSupply := math.NewUint(k.bankKeeper.GetSupply(ctx, params.Denom).Amount.Uint64())
-	msgAmount := math.NewUint(msg.Amount)
-	if currentSupply.Add(msgAmount).GT(math.NewUint(params.MaxSupply)) {
-		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max supply exceeded")
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
 	}
 
-	if err := validateMintingLimits(ctx, currentSupply, msgAmount, params); err != nil {
-		return nil, err
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return 0, err
+	}
+	if paused {
+		return 0, types.ErrPaused
 	}
 
-	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, math.NewIntFromUint64(msgAmount.Uint64())))
-	err = k.bankKeeper.MintCoins(ctx, types.ModuleName, coins)
+	authorized, err := k.IsAuthorized(ctx, signerBytes)
 	if err != nil {
-		return nil, err
+		return 0, err
+	}
+	if !authorized {
+		return 0, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
 	}
 
-	if err := k.depositCoins(ctx, params.ReceivingAddress, msg.Amount, params.Denom); err != nil {
-		return nil, err
+	if amount.IsNil() || !amount.IsPositive() {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
 	}
 
-	return &types.MsgMintResponse{}, nil
-}
+	if err := k.validateMintDestination(params, recipient, reference); err != nil {
+		return 0, err
+	}
 
-func parseDate(dateStr string) (time.Time, error) {
-	return time.Parse("2006-01-02", dateStr)
-}
+	if err := k.checkMintRateLimits(ctx, params, amount); err != nil {
+		return 0, err
+	}
 
-func (k msgServer) depositCoins(ctx context.Context, toAddress string, amount uint64, denom string) error {
-	acctBytes, err := k.addressCodec.StringToBytes(toAddress)
+	basis, err := k.maxSupplyBasis(ctx, params)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address '%s'", toAddress)
+		return 0, err
 	}
-
-	coins := sdk.NewCoins(sdk.NewCoin(denom, math.NewIntFromUint64(amount)))
-	if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, sdk.AccAddress(acctBytes), coins); err != nil {
-		return err
+	if basis.Add(amount).GT(params.MaxSupply) {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "max supply exceeded")
 	}
-	return nil
-}
 
-func validateMintingLimits(ctx sdk.Context, currentSupply math.Uint, amount math.Uint, params types.Params) error {
-	startDate, err := parseDate(params.DistributionStartDate)
+	mintedSupply, err := k.GetMintedSupply(ctx)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid distribution start date: %v", err)
+		return 0, err
 	}
-	targetDate, err := parseDate(ctx.BlockTime().Format("2006-01-02"))
+	schedule, err := validateMintingLimits(ctx, mintedSupply, amount, params)
 	if err != nil {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid target date: %v", err)
+		return 0, err
 	}
 
-	months := monthsBetween(startDate, targetDate)
-	if months < 0 {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "target date is before start date")
+	if err := k.checkMinterQuota(ctx, signerBytes, schedule, amount); err != nil {
+		return 0, err
 	}
 
-	currentHalvingPeriod := 1 + uint64(months)/params.MonthsInHalvingPeriod
-	var totalDistributable uint64
+	id, err := k.mintAndDistribute(ctx, signer, params, schedule, amount, recipient, reference)
+	if err != nil {
+		return 0, err
+	}
 
-	for period := uint64(1); period < currentHalvingPeriod; period++ {
-		totalDistributable += halvingPeriodLimit(params.MaxSupply, period)
+	if err := k.addMinterUsage(ctx, signerBytes, schedule.HalvingPeriod, amount); err != nil {
+		return 0, err
 	}
 
-	if currentHalvingPeriod > 0 {
-		periodStart := addMonths(startDate, int((currentHalvingPeriod-1)*params.MonthsInHalvingPeriod))
-		periodEnd := addMonths(startDate, int(currentHalvingPeriod*params.MonthsInHalvingPeriod)).AddDate(0, 0, -1)
+	if err := k.recordRecentMint(ctx, params, id, amount); err != nil {
+		return 0, err
+	}
 
-		daysInPeriod := uint64(periodEnd.Sub(periodStart).Hours()/24) + 1
-		periodYearlyLimit := halvingPeriodLimit(params.MaxSupply, currentHalvingPeriod)
+	return id, nil
+}
 
-		daysElapsed := uint64(targetDate.Sub(periodStart).Hours() / 24)
-		if daysElapsed > daysInPeriod {
-			daysElapsed = daysInPeriod
-		}
+// mintAndDistribute mints amount coins, sends them to recipient or, when it
+// is empty, distributes them to the recipients in params, adds them to the
+// minted supply, writes the mint ledger record and emits EventMint. It
+// returns the id of the ledger record. When denom has an enabled x/erc20
+// native coin token pair, both the record and the event name its ERC-20
+// contract. No ERC-20 Transfer log is emitted here, as Cosmos transactions
+// cannot emit EVM logs; the distro precompile emits it from the event.
+func (k Keeper) mintAndDistribute(ctx sdk.Context, signer string, params types.Params, schedule scheduleState, amount math.Int, recipient, reference string) (uint64, error) {
+	erc20Contract := k.erc20Contract(ctx, params.Denom)
+
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, amount))
+	if err := k.bankKeeper.MintCoins(ctx, types.ModuleName, coins); err != nil {
+		return 0, err
+	}
 
-		if daysInPeriod != 0 {
-			currentPeriodAmount := (periodYearlyLimit * daysElapsed) / daysInPeriod
-			totalDistributable += currentPeriodAmount
+	var payouts []types.Payout
+	if recipient != "" {
+		if _, err := k.sendToRecipient(ctx, types.Recipient{Address: recipient}, coins); err != nil {
+			return 0, err
 		}
+		payouts = []types.Payout{{Address: recipient, Amount: amount}}
+	} else {
+		recipient = params.ReceivingAddress
+		var err error
+		payouts, err = k.distributeCoins(ctx, params, amount)
+		if err != nil {
+			return 0, err
+		}
+	}
+
+	if err := k.addMintedSupply(ctx, amount); err != nil {
+		return 0, err
+	}
+
+	id, err := k.AppendMint(ctx, types.MintRecord{
+		Signer:        signer,
+		Recipient:     recipient,
+		Amount:        amount,
+		Denom:         params.Denom,
+		BlockHeight:   ctx.BlockHeight(),
+		BlockTime:     ctx.BlockTime(),
+		Payouts:       payouts,
+		Reference:     reference,
+		Erc20Contract: erc20Contract,
+	})
+	if err != nil {
+		return 0, err
 	}
 
-	if amount.Add(currentSupply).GT(math.NewUint(totalDistributable)) {
-		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount exceeds total distributable limit of %d", totalDistributable)
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMint{
+		Signer:             signer,
+		Recipient:          recipient,
+		Amount:             amount,
+		Denom:              params.Denom,
+		HalvingPeriod:      schedule.HalvingPeriod,
+		TotalDistributable: schedule.TotalDistributable,
+		SupplyAfter:        k.bankKeeper.GetSupply(ctx, params.Denom).Amount,
+		Id:                 id,
+		Payouts:            payouts,
+		Reference:          reference,
+		Erc20Contract:      erc20Contract,
+	}); err != nil {
+		return 0, err
 	}
 
-	return nil
+	return id, nil
 }
 
-// halvingPeriodLimit returns the total distributable tokens for the given halving period.
-// Guards against a division-by-zero: uint64(1) << n is 0 for n >= 64 in Go, which
-// would cause a runtime panic. Period 65+ would require shifting by >= 64 bits; in
-// practice all supply is exhausted well before that point, so the limit is 0.
-func halvingPeriodLimit(maxSupply, period uint64) uint64 {
-	if period == 0 || period > 64 {
-		return 0
+func parseDate(dateStr string) (time.Time, error) {
+	return time.Parse("2006-01-02", dateStr)
+}
+
+// validateMintingLimits checks that minting amount on top of mintedSupply, the
+// cumulative amount minted by the module, stays within the distributable cap
+// at the block time. Burns do not lower mintedSupply, so they never reopen the
+// schedule. On success it returns the schedule
+// state the check was made against.
+func validateMintingLimits(ctx sdk.Context, mintedSupply math.Int, amount math.Int, params types.Params) (scheduleState, error) {
+	state, err := scheduleAt(params, ctx.BlockTime())
+	if err != nil {
+		return scheduleState{}, err
+	}
+	if state.HalvingPeriod == 0 {
+		return scheduleState{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "target date is before start date")
+	}
+
+	if amount.Add(mintedSupply).GT(state.TotalDistributable) {
+		return scheduleState{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "amount exceeds total distributable limit of %s", state.TotalDistributable)
+	}
+
+	return state, nil
+}
+
+// halvingPeriodLimit returns the total distributable tokens for the given
+// halving period, maxSupply / 2^period rounded down. The shift is done in
+// big-int arithmetic, so it neither overflows nor divides by zero for large
+// periods; once all supply is exhausted the limit is simply 0.
+func halvingPeriodLimit(maxSupply math.Int, period uint64) math.Int {
+	if period == 0 || period > uint64(maxSupply.BigInt().BitLen()) {
+		return math.ZeroInt()
 	}
-	return maxSupply / (uint64(1) << (period - 1)) / 2
+	return math.NewIntFromBigInt(new(big.Int).Rsh(maxSupply.BigInt(), uint(period)))
 }
 
 // addMonths adds n calendar months to t, clamping the day to the last day of
@@ -0,0 +1,164 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"slices"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) ProposeMint(goCtx context.Context, msg *types.MsgProposeMint) (*types.MsgProposeMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	proposerBytes, err := k.addressCodec.StringToBytes(msg.Proposer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid proposer address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+	if params.MintApprovalThreshold == 0 {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mint approval is disabled")
+	}
+
+	paused, err := k.IsPaused(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if paused {
+		return nil, types.ErrPaused
+	}
+
+	authorized, err := k.IsAuthorized(ctx, proposerBytes)
+	if err != nil {
+		return nil, err
+	}
+	if !authorized {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+
+	if err := k.validateMintDestination(params, msg.Recipient, msg.Reference); err != nil {
+		return nil, err
+	}
+
+	id, err := k.MintProposalSequence.Next(ctx)
+	if err != nil {
+		return nil, err
+	}
+	proposal := types.MintProposal{
+		Id:           id,
+		Proposer:     msg.Proposer,
+		Amount:       msg.Amount,
+		SubmitHeight: ctx.BlockHeight(),
+		ExpiresAt:    ctx.BlockTime().Add(params.MintProposalTtl),
+		Recipient:    msg.Recipient,
+		Reference:    msg.Reference,
+	}
+	if err := k.SetMintProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintProposed{
+		Id:        id,
+		Proposer:  msg.Proposer,
+		Amount:    msg.Amount,
+		ExpiresAt: proposal.ExpiresAt,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgProposeMintResponse{Id: id}, nil
+}
+
+func (k msgServer) ApproveMint(goCtx context.Context, msg *types.MsgApproveMint) (*types.MsgApproveMintResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	approverBytes, err := k.addressCodec.StringToBytes(msg.Approver)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid approver address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+
+	approvers, err := k.mintApprovers(params)
+	if err != nil {
+		return nil, err
+	}
+	if _, ok := approvers[string(approverBytes)]; !ok {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "not a mint approver")
+	}
+
+	proposal, err := k.MintProposals.Get(ctx, msg.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, errorsmod.Wrapf(types.ErrMintProposalNotFound, "id %d", msg.Id)
+		}
+		return nil, err
+	}
+	if !ctx.BlockTime().Before(proposal.ExpiresAt) {
+		return nil, errorsmod.Wrapf(types.ErrMintProposalExpired, "expired at %s", proposal.ExpiresAt)
+	}
+	// The approver may sign with a bech32 or a hex address, so approvals are
+	// recorded and compared in their canonical form.
+	approver, err := k.addressCodec.BytesToString(approverBytes)
+	if err != nil {
+		return nil, err
+	}
+	if slices.Contains(proposal.Approvals, approver) {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "mint proposal already approved by approver")
+	}
+	proposal.Approvals = append(proposal.Approvals, approver)
+
+	count, err := k.countMintApprovals(approvers, proposal)
+	if err != nil {
+		return nil, err
+	}
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintApproved{
+		Id:        proposal.Id,
+		Approver:  approver,
+		Approvals: count,
+	}); err != nil {
+		return nil, err
+	}
+
+	if count < params.MintApprovalThreshold {
+		if err := k.SetMintProposal(ctx, proposal); err != nil {
+			return nil, err
+		}
+		return &types.MsgApproveMintResponse{}, nil
+	}
+
+	// The approval that reaches the threshold executes the mint. If the mint
+	// fails, the approval is reverted with it and the proposal stays pending.
+	mintID, err := k.mint(ctx, proposal.Proposer, proposal.Amount, proposal.Recipient, proposal.Reference)
+	if err != nil {
+		return nil, err
+	}
+	if err := k.removeMintProposal(ctx, proposal); err != nil {
+		return nil, err
+	}
+
+	if err := ctx.EventManager().EmitTypedEvent(&types.EventMintProposalExecuted{
+		Id:     proposal.Id,
+		MintId: mintID,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgApproveMintResponse{Executed: true, MintId: mintID}, nil
+}
@@ -0,0 +1,233 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// setupMintApprovals enables the mint approval flow with a 2 of 3 threshold
+// and returns the approvers.
+func setupMintApprovals(t *testing.T, f *fixture, params types.Params) []string {
+	t.Helper()
+
+	params.MintApprovers = []string{sample.AccAddress(), sample.AccAddress(), sample.AccAddress()}
+	params.MintApprovalThreshold = 2
+	params.MintProposalTtl = 24 * time.Hour
+	require.NoError(t, params.Validate())
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+	return params.MintApprovers
+}
+
+func TestMsgProposeMint(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	_, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	setupMintApprovals(t, f, params)
+
+	// MsgMint is rejected while approvals are required.
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.ErrorIs(t, err, types.ErrMintApprovalRequired)
+
+	_, err = ms.ProposeMint(ctx, types.NewMsgProposeMint(sample.AccAddress(), math.NewInt(1_000)))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.ZeroInt()))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)).WithRecipient(sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrRecipientNotAllowed)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	res, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.NoError(t, err)
+
+	expiresAt := ctx.BlockTime().Add(24 * time.Hour)
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	event, ok := msg.(*types.EventMintProposed)
+	require.True(t, ok)
+	require.Equal(t, res.Id, event.Id)
+	require.Equal(t, minter, event.Proposer)
+	requireIntEqual(t, math.NewInt(1_000), event.Amount)
+	require.Equal(t, expiresAt, event.ExpiresAt)
+
+	proposals, err := qs.MintProposals(ctx, &types.QueryMintProposalsRequest{})
+	require.NoError(t, err)
+	require.Len(t, proposals.Proposals, 1)
+
+	proposal, err := qs.MintProposal(ctx, &types.QueryMintProposalRequest{Id: res.Id})
+	require.NoError(t, err)
+	require.Equal(t, minter, proposal.Proposal.Proposer)
+	require.Equal(t, expiresAt, proposal.Proposal.ExpiresAt)
+	require.Empty(t, proposal.Proposal.Approvals)
+
+	_, err = qs.MintProposal(ctx, &types.QueryMintProposalRequest{Id: res.Id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestMsgApproveMint(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	destination := sample.AccAddress()
+	params.MintDestinations = []string{destination}
+	approvers := setupMintApprovals(t, f, params)
+
+	res, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)).WithRecipient(destination).WithReference("DISB-42"))
+	require.NoError(t, err)
+
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(sample.AccAddress(), res.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id+1))
+	require.ErrorIs(t, err, types.ErrMintProposalNotFound)
+
+	approved, err := ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id))
+	require.NoError(t, err)
+	require.False(t, approved.Executed)
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).Amount.IsZero())
+
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	// The second approval reaches the threshold and executes the mint on
+	// behalf of the proposer.
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	approved, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[2], res.Id))
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+	requireIntEqual(t, math.NewInt(1_000), f.bankKeeper.GetSupply(ctx, params.Denom).Amount)
+
+	record, err := f.keeper.Mints.Get(ctx, approved.MintId)
+	require.NoError(t, err)
+	require.Equal(t, minter, record.Signer)
+	require.Equal(t, destination, record.Recipient)
+	require.Equal(t, "DISB-42", record.Reference)
+
+	events := ctx.EventManager().Events()
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[len(events)-1]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMintProposalExecuted{Id: res.Id, MintId: approved.MintId}, msg)
+
+	has, err := f.keeper.MintProposals.Has(ctx, res.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.MintProposalsByExpiry.Has(ctx, collections.Join(ctx.BlockTime().Add(24*time.Hour), res.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[1], res.Id))
+	require.ErrorIs(t, err, types.ErrMintProposalNotFound)
+}
+
+func TestMsgApproveMintHexApprover(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	approvers := setupMintApprovals(t, f, params)
+
+	res, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.NoError(t, err)
+
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id))
+	require.NoError(t, err)
+
+	// The same approver cannot approve again under its hex address.
+	hexApprover := common.BytesToAddress(sdk.MustAccAddressFromBech32(approvers[0])).Hex()
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(hexApprover, res.Id))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	proposal, err := f.keeper.MintProposals.Get(ctx, res.Id)
+	require.NoError(t, err)
+	require.Equal(t, []string{approvers[0]}, proposal.Approvals)
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).Amount.IsZero())
+}
+
+func TestMsgApproveMintFailedExecution(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	approvers := setupMintApprovals(t, f, params)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	res, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.NoError(t, err)
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id))
+	require.NoError(t, err)
+
+	_, err = ms.Pause(ctx, types.NewMsgPause(authorityStr, "incident"))
+	require.NoError(t, err)
+
+	// The mint fails while paused, so the proposal keeps waiting.
+	cacheCtx, _ := ctx.CacheContext()
+	_, err = ms.ApproveMint(cacheCtx, types.NewMsgApproveMint(approvers[1], res.Id))
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	_, err = ms.Unpause(ctx, types.NewMsgUnpause(authorityStr))
+	require.NoError(t, err)
+	approved, err := ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[1], res.Id))
+	require.NoError(t, err)
+	require.True(t, approved.Executed)
+}
+
+func TestMintProposalExpiry(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	approvers := setupMintApprovals(t, f, params)
+
+	res, err := ms.ProposeMint(ctx, types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.NoError(t, err)
+	expiresAt := ctx.BlockTime().Add(24 * time.Hour)
+	later, err := ms.ProposeMint(ctx.WithBlockTime(ctx.BlockTime().Add(time.Hour)), types.NewMsgProposeMint(minter, math.NewInt(1_000)))
+	require.NoError(t, err)
+
+	ctx = ctx.WithBlockTime(expiresAt).WithEventManager(sdk.NewEventManager())
+	_, err = ms.ApproveMint(ctx, types.NewMsgApproveMint(approvers[0], res.Id))
+	require.ErrorIs(t, err, types.ErrMintProposalExpired)
+
+	// Only the proposal expiring at the block time is dropped, along with
+	// its expiry index entry.
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	has, err := f.keeper.MintProposals.Has(ctx, res.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.MintProposalsByExpiry.Has(ctx, collections.Join(expiresAt, res.Id))
+	require.NoError(t, err)
+	require.False(t, has)
+	has, err = f.keeper.MintProposals.Has(ctx, later.Id)
+	require.NoError(t, err)
+	require.True(t, has)
+	has, err = f.keeper.MintProposalsByExpiry.Has(ctx, collections.Join(expiresAt.Add(time.Hour), later.Id))
+	require.NoError(t, err)
+	require.True(t, has)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMintProposalExpired{Id: res.Id}, msg)
+}
@@ -1,9 +1,11 @@
 package keeper
 
 import (
+	"math/big"
 	"testing"
 	"time"
 
+	"cosmossdk.io/math"
 	"github.com/stretchr/testify/require"
 )
 
@@ -70,25 +72,32 @@ func TestMonthsBetween(t *testing.T) {
 }
 
 func TestHalvingPeriodLimit(t *testing.T) {
-	const maxSupply = uint64(35_000_000_000_000_000)
+	maxSupply := math.NewInt(35_000_000_000_000_000)
+	// bigSupply exceeds the uint64 range.
+	bigSupply := math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 100))
 
 	tests := []struct {
 		name      string
+		maxSupply math.Int
 		period    uint64
-		wantLimit uint64
+		wantLimit math.Int
 	}{
-		{"period 0 returns 0", 0, 0},
-		{"period 1 (no shift)", 1, maxSupply / 2},
-		{"period 2", 2, maxSupply / 4},
-		{"period 64 (max safe shift)", 64, maxSupply / (uint64(1) << 63) / 2},
-		{"period 65 returns 0 (shift would be 64)", 65, 0},
-		{"period 100 returns 0", 100, 0},
+		{"period 0 returns 0", maxSupply, 0, math.ZeroInt()},
+		{"period 1", maxSupply, 1, maxSupply.QuoRaw(2)},
+		{"period 2", maxSupply, 2, maxSupply.QuoRaw(4)},
+		{"period 54 (last non-zero)", maxSupply, 54, math.OneInt()},
+		{"period 55 (supply exhausted)", maxSupply, 55, math.ZeroInt()},
+		{"period 100 returns 0", maxSupply, 100, math.ZeroInt()},
+		{"supply above uint64 period 1", bigSupply, 1, math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 99))},
+		{"supply above uint64 period 65", bigSupply, 65, math.NewIntFromBigInt(new(big.Int).Lsh(big.NewInt(1), 35))},
+		{"supply above uint64 period 101", bigSupply, 101, math.ZeroInt()},
+		{"huge period returns 0", bigSupply, 1 << 40, math.ZeroInt()},
 	}
 
 	for _, tc := range tests {
 		t.Run(tc.name, func(t *testing.T) {
-			got := halvingPeriodLimit(maxSupply, tc.period)
-			require.Equal(t, tc.wantLimit, got)
+			got := halvingPeriodLimit(tc.maxSupply, tc.period)
+			require.True(t, tc.wantLimit.Equal(got), "got %s, want %s", got, tc.wantLimit)
 		})
 	}
 }
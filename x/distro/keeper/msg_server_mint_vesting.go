@@ -0,0 +1,54 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) MintVesting(goCtx context.Context, msg *types.MsgMintVesting) (*types.MsgMintVestingResponse, error) {
+	ctx := sdk.UnwrapSDKContext(goCtx)
+
+	recipient, err := k.addressCodec.StringToBytes(msg.Recipient)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address '%s'", msg.Recipient)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+	if params.MintApprovalThreshold > 0 {
+		return nil, errorsmod.Wrap(types.ErrMintApprovalRequired, "mints must be proposed with MsgProposeMint")
+	}
+
+	if msg.Amount.IsNil() || !msg.Amount.IsPositive() {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "amount must be positive")
+	}
+	if err := msg.ValidateSchedule(params.Denom); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	// The recipient must be one of the mint destinations, like the recipient
+	// of a MsgMint. A vesting schedule does not stop a minter from granting
+	// coins to an account it controls, so allowlisting a grantee is left to
+	// the authority.
+	id, err := k.mint(ctx, msg.Signer, msg.Amount, msg.Recipient, msg.Reference)
+	if err != nil {
+		return nil, err
+	}
+
+	// The minted coins are locked by turning the recipient into a vesting
+	// account, or by adding them to its vesting schedule. Should that fail,
+	// the whole message, including the mint, is reverted.
+	coins := sdk.NewCoins(sdk.NewCoin(params.Denom, msg.Amount))
+	if err := k.setupVestingAccount(ctx, recipient, msg, coins); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgMintVestingResponse{Id: id}, nil
+}
@@ -0,0 +1,167 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgMintVesting(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	contributor := sample.AccAddress()
+	params.MintDestinations = []string{contributor}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	start := ctx.BlockTime().Unix()
+	end := start + 3600
+	periods := func(amounts ...int64) []vestingtypes.Period {
+		var periods []vestingtypes.Period
+		for _, amount := range amounts {
+			periods = append(periods, vestingtypes.Period{Length: 600, Amount: sdk.NewCoins(sdk.NewInt64Coin(params.Denom, amount))})
+		}
+		return periods
+	}
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgMintVesting
+		expErr error
+	}{
+		{
+			name:   "unauthorized signer",
+			input:  types.NewMsgMintContinuousVesting(sample.AccAddress(), contributor, math.NewInt(1_000), start, end),
+			expErr: sdkerrors.ErrUnauthorized,
+		},
+		{
+			name:   "recipient not allowed",
+			input:  types.NewMsgMintContinuousVesting(minter, sample.AccAddress(), math.NewInt(1_000), start, end),
+			expErr: types.ErrRecipientNotAllowed,
+		},
+		{
+			name:   "end before start",
+			input:  types.NewMsgMintContinuousVesting(minter, contributor, math.NewInt(1_000), end, start),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "periods not adding up",
+			input:  types.NewMsgMintPeriodicVesting(minter, contributor, math.NewInt(1_000), start, periods(400, 400)),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "beyond the distributable cap",
+			input:  types.NewMsgMintDelayedVesting(minter, contributor, types.DefaultMaxSupply, end),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx, _ := ctx.CacheContext()
+			_, err := ms.MintVesting(ctx, tc.input)
+			require.ErrorIs(t, err, tc.expErr)
+		})
+	}
+
+	addr, err := f.addressCodec.StringToBytes(contributor)
+	require.NoError(t, err)
+	require.Nil(t, f.accountKeeper.GetAccount(ctx, addr))
+
+	t.Run("creates and funds a periodic vesting account", func(t *testing.T) {
+		res, err := ms.MintVesting(ctx, types.NewMsgMintPeriodicVesting(minter, contributor, math.NewInt(1_000), start, periods(600, 400)).WithReference("DISB-7"))
+		require.NoError(t, err)
+
+		acc, ok := f.accountKeeper.GetAccount(ctx, addr).(*vestingtypes.PeriodicVestingAccount)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1_000)), acc.OriginalVesting)
+		require.Equal(t, start+1200, acc.EndTime)
+		requireIntEqual(t, math.NewInt(1_000), f.bankKeeper.balances[contributor].AmountOf(params.Denom))
+
+		record, err := f.keeper.Mints.Get(ctx, res.Id)
+		require.NoError(t, err)
+		require.Equal(t, contributor, record.Recipient)
+		require.Equal(t, "DISB-7", record.Reference)
+
+		_, err = ms.MintVesting(ctx, types.NewMsgMintPeriodicVesting(minter, contributor, math.NewInt(500), start, periods(250, 250)))
+		require.NoError(t, err)
+
+		acc, ok = f.accountKeeper.GetAccount(ctx, addr).(*vestingtypes.PeriodicVestingAccount)
+		require.True(t, ok)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1_500)), acc.OriginalVesting)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 850)), acc.VestingPeriods[0].Amount)
+		require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 650)), acc.VestingPeriods[1].Amount)
+
+		// A different schedule cannot be merged into the account.
+		_, err = ms.MintVesting(ctx, types.NewMsgMintContinuousVesting(minter, contributor, math.NewInt(500), start, end))
+		require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+	})
+}
+
+func TestMsgMintVestingGranteeAllowlist(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	grantee := sample.AccAddress()
+	end := ctx.BlockTime().Unix() + 3600
+	grant := types.NewMsgMintDelayedVesting(minter, grantee, math.NewInt(1_000), end)
+
+	// A minter cannot pick the grantee of a vesting grant.
+	_, err = ms.MintVesting(ctx, grant)
+	require.ErrorIs(t, err, types.ErrRecipientNotAllowed)
+
+	// The authority allowlists the grantee, after which the minter can grant
+	// it coins.
+	params.MintDestinations = []string{grantee}
+	_, err = ms.UpdateParamsPartial(ctx, types.NewMsgUpdateParamsPartial(authorityStr, params, "mint_destinations"))
+	require.NoError(t, err)
+
+	_, err = ms.MintVesting(ctx, grant)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(1_000), f.bankKeeper.balances[grantee].AmountOf(params.Denom))
+
+	// Removing the grantee from the allowlist stops further grants.
+	params.MintDestinations = nil
+	_, err = ms.UpdateParamsPartial(ctx, types.NewMsgUpdateParamsPartial(authorityStr, params, "mint_destinations"))
+	require.NoError(t, err)
+
+	_, err = ms.MintVesting(ctx, grant)
+	require.ErrorIs(t, err, types.ErrRecipientNotAllowed)
+}
+
+func TestMsgMintVestingConvertsAccount(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	contributor := sample.AccAddress()
+	params.MintDestinations = []string{contributor}
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	addr, err := f.addressCodec.StringToBytes(contributor)
+	require.NoError(t, err)
+	f.accountKeeper.SetAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+
+	end := ctx.BlockTime().Unix() + 3600
+	_, err = ms.MintVesting(ctx, types.NewMsgMintDelayedVesting(minter, contributor, math.NewInt(1_000), end))
+	require.NoError(t, err)
+
+	acc, ok := f.accountKeeper.GetAccount(ctx, addr).(*vestingtypes.DelayedVestingAccount)
+	require.True(t, ok)
+	require.Equal(t, end, acc.EndTime)
+	require.Equal(t, sdk.NewCoins(sdk.NewInt64Coin(params.Denom, 1_000)), acc.LockedCoins(ctx.BlockTime()))
+}
@@ -0,0 +1,120 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) AddMinter(ctx context.Context, msg *types.MsgAddMinter) (*types.MsgAddMinterResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	// The minter may be given as an EVM hex address, such as the address of
+	// a Safe or timelock contract minting through the distro precompile. It
+	// is stored in its bech32 form.
+	addr, err := k.addressCodec.StringToBytes(msg.Minter.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address '%s'", msg.Minter.Address)
+	}
+	minter := msg.Minter
+	if minter.Address, err = k.addressCodec.BytesToString(addr); err != nil {
+		return nil, err
+	}
+
+	if err := minter.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	has, err := k.Minters.Has(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if has {
+		return nil, errorsmod.Wrapf(types.ErrMinterAlreadyExists, "minter %s", minter.Address)
+	}
+
+	if err := k.Minters.Set(ctx, addr, minter); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMinterAdded{
+		Minter: minter,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgAddMinterResponse{}, nil
+}
+
+func (k msgServer) RemoveMinter(ctx context.Context, msg *types.MsgRemoveMinter) (*types.MsgRemoveMinterResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	addr, err := k.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address '%s'", msg.Address)
+	}
+
+	has, err := k.Minters.Has(ctx, addr)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrMinterNotFound, "minter %s", msg.Address)
+	}
+
+	if err := k.Minters.Remove(ctx, addr); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMinterRemoved{
+		Address: msg.Address,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgRemoveMinterResponse{}, nil
+}
+
+func (k msgServer) SetMinterQuota(ctx context.Context, msg *types.MsgSetMinterQuota) (*types.MsgSetMinterQuotaResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	if err := msg.Quota.Validate(); err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	addr, err := k.addressCodec.StringToBytes(msg.Address)
+	if err != nil {
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid minter address '%s'", msg.Address)
+	}
+
+	minter, err := k.Minters.Get(ctx, addr)
+	if err != nil {
+		return nil, errorsmod.Wrapf(types.ErrMinterNotFound, "minter %s", msg.Address)
+	}
+
+	oldQuota := minter.Quota
+	minter.Quota = msg.Quota
+	if err := k.Minters.Set(ctx, addr, minter); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMinterQuotaSet{
+		Address: msg.Address,
+		Old:     oldQuota,
+		New:     msg.Quota,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgSetMinterQuotaResponse{}, nil
+}
@@ -0,0 +1,177 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgAddMinter(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	existing := addMinter(t, f, types.UnlimitedMinterQuota())
+	minter := sample.AccAddress()
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgAddMinter
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgAddMinter(sample.AccAddress(), types.NewMinter(minter, types.UnlimitedMinterQuota())),
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "invalid quota",
+			input:  types.NewMsgAddMinter(authorityStr, types.NewMinter(minter, types.NewMinterQuota(math.LegacyNewDec(2), math.ZeroInt()))),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "already registered",
+			input:  types.NewMsgAddMinter(authorityStr, types.NewMinter(existing, types.UnlimitedMinterQuota())),
+			expErr: types.ErrMinterAlreadyExists,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgAddMinter(authorityStr, types.NewMinter(minter, types.NewMinterQuota(math.LegacyNewDecWithPrec(5, 1), math.NewInt(1_000)))),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+			_, err := ms.AddMinter(ctx, tc.input)
+
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			addr, err := f.addressCodec.StringToBytes(tc.input.Minter.Address)
+			require.NoError(t, err)
+			got, err := f.keeper.Minters.Get(ctx, addr)
+			require.NoError(t, err)
+			require.Equal(t, tc.input.Minter, got)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+			require.NoError(t, err)
+			require.Equal(t, &types.EventMinterAdded{Minter: tc.input.Minter}, msg)
+		})
+	}
+}
+
+func TestMsgRemoveMinter(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	_, err = ms.RemoveMinter(ctx, types.NewMsgRemoveMinter(sample.AccAddress(), minter))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.RemoveMinter(ctx, types.NewMsgRemoveMinter(authorityStr, sample.AccAddress()))
+	require.ErrorIs(t, err, types.ErrMinterNotFound)
+
+	_, err = ms.RemoveMinter(ctx, types.NewMsgRemoveMinter(authorityStr, minter))
+	require.NoError(t, err)
+
+	addr, err := f.addressCodec.StringToBytes(minter)
+	require.NoError(t, err)
+	authorized, err := f.keeper.IsAuthorized(ctx, addr)
+	require.NoError(t, err)
+	require.False(t, authorized)
+
+	// Usage history is kept for auditability.
+	total, err := f.keeper.GetMintedTotal(ctx, addr)
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(1_000), total)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.ErrorContains(t, err, "unauthorized sender")
+}
+
+func TestMsgSetMinterQuota(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	quota := types.NewMinterQuota(math.LegacyZeroDec(), math.NewInt(1_500))
+
+	_, err = ms.SetMinterQuota(ctx, types.NewMsgSetMinterQuota(sample.AccAddress(), minter, quota))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.SetMinterQuota(ctx, types.NewMsgSetMinterQuota(authorityStr, sample.AccAddress(), quota))
+	require.ErrorIs(t, err, types.ErrMinterNotFound)
+
+	_, err = ms.SetMinterQuota(ctx, types.NewMsgSetMinterQuota(authorityStr, minter, types.NewMinterQuota(math.LegacyNewDec(-1), math.ZeroInt())))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.SetMinterQuota(ctx, types.NewMsgSetMinterQuota(authorityStr, minter, quota))
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventMinterQuotaSet{Address: minter, Old: types.UnlimitedMinterQuota(), New: quota}, msg)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.ErrorIs(t, err, types.ErrMinterQuotaExceeded)
+}
+
+func TestMsgAddMinterEVMAddress(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, _ := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	// A Safe registered by its EVM hex address mints as the bech32 form of
+	// the same address, which is how the distro precompile reports its caller.
+	safe := common.HexToAddress("0x5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE")
+	_, err = ms.AddMinter(ctx, types.NewMsgAddMinter(authorityStr, types.NewMinter(safe.Hex(), types.UnlimitedMinterQuota())))
+	require.NoError(t, err)
+
+	bech32, err := f.addressCodec.BytesToString(safe.Bytes())
+	require.NoError(t, err)
+	got, err := f.keeper.Minters.Get(ctx, safe.Bytes())
+	require.NoError(t, err)
+	require.Equal(t, bech32, got.Address)
+
+	_, err = ms.AddMinter(ctx, types.NewMsgAddMinter(authorityStr, types.NewMinter(bech32, types.UnlimitedMinterQuota())))
+	require.ErrorIs(t, err, types.ErrMinterAlreadyExists)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), bech32))
+	require.NoError(t, err)
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), safe.Hex()))
+	require.NoError(t, err)
+}
@@ -0,0 +1,55 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) ScheduleParamsUpdate(ctx context.Context, msg *types.MsgScheduleParamsUpdate) (*types.MsgScheduleParamsUpdateResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	id, err := k.Keeper.ScheduleParamsUpdate(ctx, types.ScheduledParamsUpdate{
+		Params:                msg.Params,
+		UpdateMask:            msg.UpdateMask,
+		ActivationHeight:      msg.ActivationHeight,
+		ActivationTime:        msg.ActivationTime,
+		OverrideScheduleGuard: msg.OverrideScheduleGuard,
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	return &types.MsgScheduleParamsUpdateResponse{Id: id}, nil
+}
+
+func (k msgServer) CancelParamsUpdate(ctx context.Context, msg *types.MsgCancelParamsUpdate) (*types.MsgCancelParamsUpdateResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	has, err := k.PendingParamsUpdates.Has(ctx, msg.Id)
+	if err != nil {
+		return nil, err
+	}
+	if !has {
+		return nil, errorsmod.Wrapf(types.ErrParamsUpdateNotFound, "id %d", msg.Id)
+	}
+
+	if err := k.PendingParamsUpdates.Remove(ctx, msg.Id); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventParamsUpdateCancelled{
+		Id: msg.Id,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgCancelParamsUpdateResponse{}, nil
+}
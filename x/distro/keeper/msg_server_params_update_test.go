@@ -0,0 +1,361 @@
+package keeper_test
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgScheduleParamsUpdate(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, _ := setupMint(t, f)
+	ctx = ctx.WithBlockHeight(10)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	full := params
+	full.MonthsInHalvingPeriod = 24
+	update := types.Params{MonthsInHalvingPeriod: 24}
+	activation := ctx.BlockTime().Add(time.Hour)
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgScheduleParamsUpdate
+		expErr error
+		errMsg string
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgScheduleParamsUpdate(sample.AccAddress(), full, 20),
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "no activation",
+			input:  types.NewMsgScheduleParamsUpdate(authorityStr, full, 0),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name: "both activations",
+			input: &types.MsgScheduleParamsUpdate{
+				Authority:        authorityStr,
+				Params:           full,
+				ActivationHeight: 20,
+				ActivationTime:   &activation,
+			},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "past height",
+			input:  types.NewMsgScheduleParamsUpdate(authorityStr, full, 10),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "past time",
+			input:  types.NewMsgScheduleParamsUpdate(authorityStr, full, 0).WithActivationTime(ctx.BlockTime()),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "invalid params",
+			input:  types.NewMsgScheduleParamsUpdate(authorityStr, update, 20),
+			errMsg: "receiving address cannot be empty",
+		},
+		{
+			name:   "invalid mask",
+			input:  types.NewMsgScheduleParamsUpdate(authorityStr, update, 20).WithUpdateMask("minting_address"),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:  "full update at height",
+			input: types.NewMsgScheduleParamsUpdate(authorityStr, full, 20),
+		},
+		{
+			name:  "partial update at time",
+			input: types.NewMsgScheduleParamsUpdate(authorityStr, update, 0).WithActivationTime(activation).WithUpdateMask("months_in_halving_period"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := ctx.WithEventManager(sdk.NewEventManager())
+			res, err := ms.ScheduleParamsUpdate(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			if tc.errMsg != "" {
+				require.ErrorContains(t, err, tc.errMsg)
+				return
+			}
+			require.NoError(t, err)
+
+			// Scheduling does not change the current params.
+			got, err := f.keeper.Params.Get(ctx)
+			require.NoError(t, err)
+			require.True(t, params.Equal(got))
+
+			pending, err := f.keeper.PendingParamsUpdates.Get(ctx, res.Id)
+			require.NoError(t, err)
+			require.Equal(t, int64(10), pending.ScheduledHeight)
+			require.Equal(t, uint64(24), pending.Params.MonthsInHalvingPeriod)
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+			require.NoError(t, err)
+			require.Equal(t, res.Id, msg.(*types.EventParamsUpdateScheduled).Update.Id)
+		})
+	}
+}
+
+func TestMsgCancelParamsUpdate(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, _ := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	res, err := ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, params, 20))
+	require.NoError(t, err)
+
+	_, err = ms.CancelParamsUpdate(ctx, types.NewMsgCancelParamsUpdate(sample.AccAddress(), res.Id))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	_, err = ms.CancelParamsUpdate(ctx, types.NewMsgCancelParamsUpdate(authorityStr, res.Id+1))
+	require.ErrorIs(t, err, types.ErrParamsUpdateNotFound)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.CancelParamsUpdate(ctx, types.NewMsgCancelParamsUpdate(authorityStr, res.Id))
+	require.NoError(t, err)
+
+	has, err := f.keeper.PendingParamsUpdates.Has(ctx, res.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventParamsUpdateCancelled{Id: res.Id}, msg)
+
+	_, err = ms.CancelParamsUpdate(ctx, types.NewMsgCancelParamsUpdate(authorityStr, res.Id))
+	require.ErrorIs(t, err, types.ErrParamsUpdateNotFound)
+}
+
+func TestBeginBlockerAppliesScheduledParamsUpdates(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, _ := setupMint(t, f)
+	ctx = ctx.WithBlockHeight(10)
+	params.AutoMintEpochIdentifier = "day"
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	receiving := sample.AccAddress()
+	byHeight, err := ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, types.Params{MonthsInHalvingPeriod: 24}, 20).WithUpdateMask("months_in_halving_period"))
+	require.NoError(t, err)
+	activation := ctx.BlockTime().Add(time.Hour)
+	byTime, err := ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, types.Params{ReceivingAddress: receiving}, 0).WithActivationTime(activation).WithUpdateMask("receiving_address"))
+	require.NoError(t, err)
+	// Both are valid when scheduled, but the first clears the epoch
+	// identifier that the second relies on once it activates.
+	_, err = ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, types.Params{}, 20).WithUpdateMask("auto_mint_epoch_identifier"))
+	require.NoError(t, err)
+	failing, err := ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, types.Params{
+		AutoMint: types.AutoMintMode_AUTO_MINT_MODE_EPOCH,
+	}, 20).WithUpdateMask("auto_mint"))
+	require.NoError(t, err)
+
+	getParams := func() types.Params {
+		got, err := f.keeper.Params.Get(ctx)
+		require.NoError(t, err)
+		return got
+	}
+
+	// Nothing is due yet.
+	require.NoError(t, f.keeper.BeginBlocker(ctx.WithBlockHeight(19)))
+	require.True(t, params.Equal(getParams()))
+
+	// The height based updates activate; the failing one is dropped.
+	ctx = ctx.WithBlockHeight(20).WithEventManager(sdk.NewEventManager())
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, uint64(24), getParams().MonthsInHalvingPeriod)
+	require.Equal(t, params.ReceivingAddress, getParams().ReceivingAddress)
+	require.Empty(t, getParams().AutoMintEpochIdentifier)
+	require.Equal(t, types.AutoMintMode_AUTO_MINT_MODE_DISABLED, getParams().AutoMint)
+
+	// The failed update's own applied event is discarded with its cache.
+	var applied, failed []uint64
+	for _, event := range ctx.EventManager().Events() {
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			continue
+		}
+		switch e := msg.(type) {
+		case *types.EventScheduledParamsUpdateApplied:
+			applied = append(applied, e.Id)
+		case *types.EventScheduledParamsUpdateFailed:
+			failed = append(failed, e.Id)
+		}
+	}
+	require.Equal(t, []uint64{byHeight.Id, byHeight.Id + 2}, applied)
+	require.Equal(t, []uint64{failing.Id}, failed)
+
+	for _, id := range []uint64{byHeight.Id, failing.Id} {
+		has, err := f.keeper.PendingParamsUpdates.Has(ctx, id)
+		require.NoError(t, err)
+		require.False(t, has)
+	}
+
+	// The time based update activates once the block time is reached.
+	ctx = ctx.WithBlockHeight(21).WithBlockTime(activation)
+	require.NoError(t, f.keeper.BeginBlocker(ctx))
+	require.Equal(t, receiving, getParams().ReceivingAddress)
+	has, err := f.keeper.PendingParamsUpdates.Has(ctx, byTime.Id)
+	require.NoError(t, err)
+	require.False(t, has)
+}
+
+func TestPendingParamsUpdatesQuery(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, params, _ := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	var ids []uint64
+	for _, height := range []int64{20, 30} {
+		res, err := ms.ScheduleParamsUpdate(ctx, types.NewMsgScheduleParamsUpdate(authorityStr, params, height))
+		require.NoError(t, err)
+		ids = append(ids, res.Id)
+	}
+
+	res, err := qs.PendingParamsUpdates(ctx, &types.QueryPendingParamsUpdatesRequest{})
+	require.NoError(t, err)
+	require.Len(t, res.Updates, 2)
+	require.Equal(t, ids[0], res.Updates[0].Id)
+	require.Equal(t, int64(30), res.Updates[1].ActivationHeight)
+
+	one, err := qs.PendingParamsUpdate(ctx, &types.QueryPendingParamsUpdateRequest{Id: ids[1]})
+	require.NoError(t, err)
+	require.Equal(t, int64(30), one.Update.ActivationHeight)
+
+	_, err = qs.PendingParamsUpdate(ctx, &types.QueryPendingParamsUpdateRequest{Id: ids[1] + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
+
+func TestScheduledParamsUpdateScheduleGuard(t *testing.T) {
+	testCases := []struct {
+		name   string
+		params func(types.Params) types.Params
+		mask   string
+		// applies and appliesWithOverride tell whether the update applies
+		// at activation without and with the override.
+		applies             bool
+		appliesWithOverride bool
+	}{
+		{
+			// The new schedule unlocks nothing yet when the update is
+			// scheduled, but more than the minted supply once it activates.
+			name: "restarting the schedule below the supply minted when scheduled",
+			params: func(types.Params) types.Params {
+				return types.Params{DistributionStartDate: "2026-01-22"}
+			},
+			mask:                "distribution_start_date",
+			applies:             true,
+			appliesWithOverride: true,
+		},
+		{
+			name: "lowering the schedule below the supply minted at activation",
+			params: func(types.Params) types.Params {
+				return types.Params{MaxSupply: math.NewInt(1_000)}
+			},
+			mask: "max_supply",
+		},
+		{
+			name: "unlocking more than the max unlock jump",
+			params: func(p types.Params) types.Params {
+				return types.Params{MaxSupply: p.MaxSupply.MulRaw(2)}
+			},
+			mask:                "max_supply",
+			appliesWithOverride: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		for _, override := range []bool{false, true} {
+			t.Run(fmt.Sprintf("%s override=%t", tc.name, override), func(t *testing.T) {
+				f := initFixture(t)
+				ms := keeper.NewMsgServerImpl(f.keeper)
+				ctx, params, minter := setupMint(t, f)
+				params.MaxUnlockJump = math.NewInt(1_000_000)
+				require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+				authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+				require.NoError(t, err)
+
+				_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(2_000), minter))
+				require.NoError(t, err)
+
+				// The schedule guard is not checked when the update is
+				// scheduled, whatever the override.
+				activation := ctx.BlockTime().Add(48 * time.Hour)
+				msg := types.NewMsgScheduleParamsUpdate(authorityStr, tc.params(params), 0).WithActivationTime(activation).WithUpdateMask(tc.mask)
+				msg.OverrideScheduleGuard = override
+				scheduled, err := ms.ScheduleParamsUpdate(ctx, msg)
+				require.NoError(t, err)
+
+				ctx = ctx.WithBlockHeight(ctx.BlockHeight() + 1).WithBlockTime(activation).WithEventManager(sdk.NewEventManager())
+				require.NoError(t, f.keeper.BeginBlocker(ctx))
+
+				var applied, failed []uint64
+				for _, event := range ctx.EventManager().Events() {
+					msg, err := sdk.ParseTypedEvent(abci.Event(event))
+					if err != nil {
+						continue
+					}
+					switch e := msg.(type) {
+					case *types.EventScheduledParamsUpdateApplied:
+						applied = append(applied, e.Id)
+					case *types.EventScheduledParamsUpdateFailed:
+						require.Contains(t, e.Error, types.ErrScheduleGuard.Error())
+						failed = append(failed, e.Id)
+					}
+				}
+
+				got, err := f.keeper.Params.Get(ctx)
+				require.NoError(t, err)
+				if tc.applies || (override && tc.appliesWithOverride) {
+					require.Equal(t, []uint64{scheduled.Id}, applied)
+					require.Empty(t, failed)
+					require.False(t, params.Equal(got))
+				} else {
+					require.Empty(t, applied)
+					require.Equal(t, []uint64{scheduled.Id}, failed)
+					require.True(t, params.Equal(got))
+				}
+
+				_, broken := keeper.AllInvariants(f.keeper)(ctx)
+				require.False(t, broken)
+			})
+		}
+	}
+}
@@ -0,0 +1,85 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) Pause(ctx context.Context, msg *types.MsgPause) (*types.MsgPauseResponse, error) {
+	signerBytes, err := k.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, errorsmod.Wrap(err, "invalid signer address")
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrNotFound, "module params not initialized")
+	}
+
+	if !bytes.Equal(k.GetAuthority(), signerBytes) {
+		guardian, err := k.addressCodec.StringToBytes(params.Guardian)
+		if params.Guardian == "" || err != nil || !bytes.Equal(guardian, signerBytes) {
+			return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "only the authority or the guardian may pause minting")
+		}
+	}
+
+	status, err := k.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if status.Paused {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minting is already paused")
+	}
+
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+	if err := k.PauseStatus.Set(ctx, types.PauseStatus{
+		Paused:      true,
+		PausedBy:    msg.Signer,
+		Reason:      msg.Reason,
+		BlockHeight: sdkCtx.BlockHeight(),
+		BlockTime:   sdkCtx.BlockTime(),
+	}); err != nil {
+		return nil, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventPaused{
+		Signer: msg.Signer,
+		Reason: msg.Reason,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgPauseResponse{}, nil
+}
+
+func (k msgServer) Unpause(ctx context.Context, msg *types.MsgUnpause) (*types.MsgUnpauseResponse, error) {
+	if err := k.validateAuthority(msg.Authority); err != nil {
+		return nil, err
+	}
+
+	status, err := k.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if !status.Paused {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "minting is not paused")
+	}
+
+	if err := k.PauseStatus.Set(ctx, types.PauseStatus{}); err != nil {
+		return nil, err
+	}
+
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventUnpaused{
+		Authority: msg.Authority,
+	}); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUnpauseResponse{}, nil
+}
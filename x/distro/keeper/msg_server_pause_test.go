@@ -0,0 +1,106 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgPause(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+	ctx = ctx.WithBlockHeight(10).WithEventManager(sdk.NewEventManager())
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+	guardian := sample.AccAddress()
+	params.Guardian = guardian
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err = ms.Pause(ctx, types.NewMsgPause(sample.AccAddress(), "not allowed"))
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+
+	_, err = ms.Unpause(ctx, types.NewMsgUnpause(authorityStr))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.Pause(ctx, types.NewMsgPause(guardian, "incident"))
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventPaused{Signer: guardian, Reason: "incident"}, msg)
+
+	res, err := qs.PauseStatus(ctx, &types.QueryPauseStatusRequest{})
+	require.NoError(t, err)
+	require.Equal(t, types.PauseStatus{
+		Paused:      true,
+		PausedBy:    guardian,
+		Reason:      "incident",
+		BlockHeight: 10,
+		BlockTime:   ctx.BlockTime(),
+	}, res.Status)
+
+	_, err = ms.Pause(ctx, types.NewMsgPause(authorityStr, "again"))
+	require.ErrorIs(t, err, sdkerrors.ErrInvalidRequest)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.ErrorIs(t, err, types.ErrPaused)
+
+	// The guardian may pause but not unpause.
+	_, err = ms.Unpause(ctx, types.NewMsgUnpause(guardian))
+	require.ErrorIs(t, err, types.ErrInvalidSigner)
+
+	ctx = ctx.WithEventManager(sdk.NewEventManager())
+	_, err = ms.Unpause(ctx, types.NewMsgUnpause(authorityStr))
+	require.NoError(t, err)
+
+	events = ctx.EventManager().Events()
+	require.Len(t, events, 1)
+	msg, err = sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	require.Equal(t, &types.EventUnpaused{Authority: authorityStr}, msg)
+
+	res, err = qs.PauseStatus(ctx, &types.QueryPauseStatusRequest{})
+	require.NoError(t, err)
+	require.False(t, res.Status.Paused)
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	// The authority may pause without a guardian.
+	_, err = ms.Pause(ctx, types.NewMsgPause(authorityStr, ""))
+	require.NoError(t, err)
+}
+
+func TestAutoMintPaused(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, _ := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	_, err = ms.Pause(ctx, types.NewMsgPause(authorityStr, "incident"))
+	require.NoError(t, err)
+
+	require.NoError(t, f.keeper.AutoMint(ctx))
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).Amount.IsZero())
+
+	// Distribution skipped while paused is caught up after unpausing.
+	_, err = ms.Unpause(ctx, types.NewMsgUnpause(authorityStr))
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.AutoMint(ctx))
+	require.True(t, f.bankKeeper.GetSupply(ctx, params.Denom).Amount.IsPositive())
+}
@@ -1,32 +1,50 @@
 package keeper
 
 import (
-	"bytes"
 	"context"
 
-	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
 
 	"github.com/gnodi-network/gnodi/x/distro/types"
 )
 
 func (k msgServer) UpdateParams(ctx context.Context, req *types.MsgUpdateParams) (*types.MsgUpdateParamsResponse, error) {
-	authority, err := k.addressCodec.StringToBytes(req.Authority)
-	if err != nil {
-		return nil, errorsmod.Wrap(err, "invalid authority address")
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
 	}
 
-	if !bytes.Equal(k.GetAuthority(), authority) {
-		expectedAuthorityStr, _ := k.addressCodec.BytesToString(k.GetAuthority())
-		return nil, errorsmod.Wrapf(types.ErrInvalidSigner, "invalid authority; expected %s, got %s", expectedAuthorityStr, req.Authority)
+	if err := k.setParams(ctx, req.Params, req.OverrideScheduleGuard); err != nil {
+		return nil, err
 	}
 
-	if err := req.Params.Validate(); err != nil {
-		return nil, err
+	return &types.MsgUpdateParamsResponse{}, nil
+}
+
+// setParams validates params as a whole, replaces the current params with
+// them and emits EventParamsUpdated. The change must also pass the schedule
+// guard, whose max unlock jump is not enforced when override is set.
+func (k Keeper) setParams(ctx context.Context, params types.Params, override bool) error {
+	if err := params.Validate(); err != nil {
+		return err
+	}
+	if err := k.validateRecipientModules(params); err != nil {
+		return err
 	}
 
-	if err := k.Params.Set(ctx, req.Params); err != nil {
-		return nil, err
+	oldParams, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	if err := k.validateScheduleChange(ctx, oldParams, params, override); err != nil {
+		return err
 	}
 
-	return &types.MsgUpdateParamsResponse{}, nil
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventParamsUpdated{
+		Old: oldParams,
+		New: params,
+	})
 }
@@ -0,0 +1,57 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (k msgServer) UpdateParamsPartial(ctx context.Context, req *types.MsgUpdateParamsPartial) (*types.MsgUpdateParamsPartialResponse, error) {
+	if err := k.validateAuthority(req.Authority); err != nil {
+		return nil, err
+	}
+
+	if req.UpdateMask == nil {
+		return nil, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "update mask cannot be empty")
+	}
+
+	if err := k.setParamsPartial(ctx, req.Params, req.UpdateMask.Paths, req.OverrideScheduleGuard); err != nil {
+		return nil, err
+	}
+
+	return &types.MsgUpdateParamsPartialResponse{}, nil
+}
+
+// setParamsPartial replaces the params fields named by paths with the ones
+// of update, validating only those fields, and emits
+// EventParamsPartiallyUpdated. The change must also pass the schedule guard,
+// whose max unlock jump is not enforced when override is set.
+func (k Keeper) setParamsPartial(ctx context.Context, update types.Params, paths []string, override bool) error {
+	oldParams, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+
+	params, changes, err := types.ApplyParamsUpdate(oldParams, update, paths)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if err := k.validateRecipientModules(params); err != nil {
+		return err
+	}
+	if err := k.validateScheduleChange(ctx, oldParams, params, override); err != nil {
+		return err
+	}
+
+	if err := k.Params.Set(ctx, params); err != nil {
+		return err
+	}
+
+	return sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventParamsPartiallyUpdated{
+		Changes: changes,
+	})
+}
@@ -0,0 +1,101 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMsgUpdateParamsPartial(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+
+	// The default params lack a receiving address, which a full update would
+	// reject.
+	params := types.DefaultParams()
+	require.NoError(t, f.keeper.Params.Set(f.ctx, params))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	update := types.Params{
+		MonthsInHalvingPeriod: 24,
+		Recipients:            []types.Recipient{types.NewModuleRecipient("unknown", math.LegacyOneDec())},
+		AutoMint:              types.AutoMintMode_AUTO_MINT_MODE_EPOCH,
+	}
+
+	testCases := []struct {
+		name   string
+		input  *types.MsgUpdateParamsPartial
+		expErr error
+	}{
+		{
+			name:   "invalid authority",
+			input:  types.NewMsgUpdateParamsPartial(sample.AccAddress(), update, "months_in_halving_period"),
+			expErr: types.ErrInvalidSigner,
+		},
+		{
+			name:   "nil update mask",
+			input:  &types.MsgUpdateParamsPartial{Authority: authorityStr, Params: update},
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "deprecated field",
+			input:  types.NewMsgUpdateParamsPartial(authorityStr, update, "minting_address"),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "invalid new value",
+			input:  types.NewMsgUpdateParamsPartial(authorityStr, update, "denom"),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "dependent field is validated",
+			input:  types.NewMsgUpdateParamsPartial(authorityStr, update, "auto_mint"),
+			expErr: sdkerrors.ErrInvalidRequest,
+		},
+		{
+			name:   "unknown module recipient",
+			input:  types.NewMsgUpdateParamsPartial(authorityStr, update, "recipients"),
+			expErr: sdkerrors.ErrUnknownAddress,
+		},
+		{
+			name:  "all good",
+			input: types.NewMsgUpdateParamsPartial(authorityStr, update, "months_in_halving_period"),
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+			_, err := ms.UpdateParamsPartial(ctx, tc.input)
+			if tc.expErr != nil {
+				require.ErrorIs(t, err, tc.expErr)
+				return
+			}
+			require.NoError(t, err)
+
+			got, err := f.keeper.Params.Get(ctx)
+			require.NoError(t, err)
+			expected := params
+			expected.MonthsInHalvingPeriod = 24
+			require.True(t, expected.Equal(got))
+
+			events := ctx.EventManager().Events()
+			require.Len(t, events, 1)
+			msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+			require.NoError(t, err)
+			require.Equal(t, &types.EventParamsPartiallyUpdated{
+				Changes: []types.ParamChange{{Field: "months_in_halving_period", Old: "12", New: "24"}},
+			}, msg)
+		})
+	}
+}
@@ -3,6 +3,11 @@ package keeper_test
 import (
 	"testing"
 
+	"cosmossdk.io/math"
+	abci "github.com/cometbft/cometbft/abci/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+	stakingtypes "github.com/cosmos/cosmos-sdk/x/staking/types"
 	"github.com/stretchr/testify/require"
 
 	"github.com/gnodi-network/gnodi/x/distro/keeper"
@@ -42,17 +47,82 @@ func TestMsgUpdateParams(t *testing.T) {
 				Params:    types.Params{},
 			},
 			expErr:    true,
-			expErrMsg: "minting address cannot be empty",
+			expErrMsg: "receiving address cannot be empty",
+		},
+		{
+			name: "unknown module recipient",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					ReceivingAddress:      authorityStr,
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+					Recipients:            []types.Recipient{types.NewModuleRecipient("unknown", math.LegacyOneDec())},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "module account unknown does not exist",
+		},
+		{
+			name: "distro module recipient",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					ReceivingAddress:      authorityStr,
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+					Recipients:            []types.Recipient{types.NewModuleRecipient(types.ModuleName, math.LegacyOneDec())},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "module account distro is not allowed to receive mints",
+		},
+		{
+			name: "blocked module recipient",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					ReceivingAddress:      authorityStr,
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+					Recipients:            []types.Recipient{types.NewModuleRecipient(stakingtypes.BondedPoolName, math.LegacyOneDec())},
+				},
+			},
+			expErr:    true,
+			expErrMsg: "module account bonded_tokens_pool is not allowed to receive mints",
+		},
+		{
+			name: "community pool and gov recipients",
+			input: &types.MsgUpdateParams{
+				Authority: authorityStr,
+				Params: types.Params{
+					ReceivingAddress:      authorityStr,
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+					Recipients: []types.Recipient{
+						types.NewModuleRecipient(distrtypes.ModuleName, math.LegacyNewDecWithPrec(5, 1)),
+						types.NewModuleRecipient(types.GovModuleName, math.LegacyNewDecWithPrec(5, 1)),
+					},
+				},
+			},
+			expErr: false,
 		},
 		{
 			name: "all good",
 			input: &types.MsgUpdateParams{
 				Authority: authorityStr,
 				Params: types.NewParams(
-					authorityStr,
 					authorityStr,
 					"uGNOD",
-					35_000_000_000_000_000,
+					math.NewInt(35_000_000_000_000_000),
 					"2025-07-22",
 					12,
 				),
@@ -74,3 +144,158 @@ func TestMsgUpdateParams(t *testing.T) {
 		})
 	}
 }
+
+func TestMsgUpdateParamsEmitsEvent(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithEventManager(sdk.NewEventManager())
+
+	oldParams := types.DefaultParams()
+	require.NoError(t, f.keeper.Params.Set(ctx, oldParams))
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	newParams := types.NewParams(authorityStr, "uGNOD", math.NewInt(35_000_000_000_000_000), "2025-07-22", 24)
+	_, err = ms.UpdateParams(ctx, &types.MsgUpdateParams{Authority: authorityStr, Params: newParams})
+	require.NoError(t, err)
+
+	events := ctx.EventManager().Events()
+	require.Len(t, events, 1)
+
+	msg, err := sdk.ParseTypedEvent(abci.Event(events[0]))
+	require.NoError(t, err)
+	event, ok := msg.(*types.EventParamsUpdated)
+	require.True(t, ok)
+	require.True(t, oldParams.Equal(event.Old))
+	require.True(t, newParams.Equal(event.New))
+}
+
+func TestMsgUpdateParamsScheduleGuard(t *testing.T) {
+	f := initFixture(t)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	authorityStr, err := f.addressCodec.BytesToString(f.keeper.GetAuthority())
+	require.NoError(t, err)
+
+	params.MaxUnlockJump = math.NewInt(1_000_000)
+	require.NoError(t, f.keeper.Params.Set(ctx, params))
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	withParams := func(modify func(*types.Params)) types.Params {
+		p := params
+		modify(&p)
+		return p
+	}
+
+	testCases := []struct {
+		name        string
+		update      func(ctx sdk.Context, override bool) error
+		expErr      bool
+		overridable bool
+	}{
+		{
+			name: "shrinking the max supply below the minted supply",
+			update: func(ctx sdk.Context, override bool) error {
+				_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+					Authority:             authorityStr,
+					Params:                withParams(func(p *types.Params) { p.MaxSupply = math.NewInt(1_000) }),
+					OverrideScheduleGuard: override,
+				})
+				return err
+			},
+			expErr: true,
+		},
+		{
+			name: "moving the start date past the minted supply",
+			update: func(ctx sdk.Context, override bool) error {
+				msg := types.NewMsgUpdateParamsPartial(authorityStr, types.Params{DistributionStartDate: "2026-01-22"}, "distribution_start_date")
+				msg.OverrideScheduleGuard = override
+				_, err := ms.UpdateParamsPartial(ctx, msg)
+				return err
+			},
+			expErr: true,
+		},
+		{
+			name: "unlocking more than the max unlock jump",
+			update: func(ctx sdk.Context, override bool) error {
+				_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+					Authority:             authorityStr,
+					Params:                withParams(func(p *types.Params) { p.MaxSupply = p.MaxSupply.MulRaw(2) }),
+					OverrideScheduleGuard: override,
+				})
+				return err
+			},
+			expErr:      true,
+			overridable: true,
+		},
+		{
+			name: "lifting the max unlock jump in the same change",
+			update: func(ctx sdk.Context, override bool) error {
+				_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+					Authority: authorityStr,
+					Params: withParams(func(p *types.Params) {
+						p.MaxSupply = p.MaxSupply.MulRaw(2)
+						p.MaxUnlockJump = math.ZeroInt()
+					}),
+					OverrideScheduleGuard: override,
+				})
+				return err
+			},
+			expErr:      true,
+			overridable: true,
+		},
+		{
+			name: "unlocking less than the max unlock jump",
+			update: func(ctx sdk.Context, override bool) error {
+				_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+					Authority:             authorityStr,
+					Params:                withParams(func(p *types.Params) { p.MaxSupply = p.MaxSupply.AddRaw(1_000) }),
+					OverrideScheduleGuard: override,
+				})
+				return err
+			},
+		},
+		{
+			name: "lowering the schedule above the minted supply",
+			update: func(ctx sdk.Context, override bool) error {
+				_, err := ms.UpdateParams(ctx, &types.MsgUpdateParams{
+					Authority:             authorityStr,
+					Params:                withParams(func(p *types.Params) { p.MaxSupply = p.MaxSupply.QuoRaw(2) }),
+					OverrideScheduleGuard: override,
+				})
+				return err
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			cacheCtx, _ := ctx.CacheContext()
+			err := tc.update(cacheCtx, false)
+			if !tc.expErr {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorIs(t, err, types.ErrScheduleGuard)
+
+			got, err := f.keeper.Params.Get(cacheCtx)
+			require.NoError(t, err)
+			require.True(t, params.Equal(got))
+
+			// The override flag lifts the max unlock jump only, so that the
+			// supply invariants keep holding.
+			err = tc.update(cacheCtx, true)
+			if !tc.overridable {
+				require.ErrorIs(t, err, types.ErrScheduleGuard)
+				return
+			}
+			require.NoError(t, err)
+			_, broken := keeper.AllInvariants(f.keeper)(cacheCtx)
+			require.False(t, broken)
+		})
+	}
+}
@@ -0,0 +1,123 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	gogotypes "github.com/cosmos/gogoproto/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// applyParamsUpdate replaces the current params with params, or only the
+// fields named by mask when it is set.
+func (k Keeper) applyParamsUpdate(ctx context.Context, params types.Params, mask *gogotypes.FieldMask, override bool) error {
+	if mask == nil {
+		return k.setParams(ctx, params, override)
+	}
+	return k.setParamsPartial(ctx, params, mask.Paths, override)
+}
+
+// validateParamsUpdate validates the params that result from replacing the
+// current params with params, or only the fields named by mask when it is
+// set. The schedule guard is not checked.
+func (k Keeper) validateParamsUpdate(ctx context.Context, params types.Params, mask *gogotypes.FieldMask) error {
+	if mask == nil {
+		if err := params.Validate(); err != nil {
+			return err
+		}
+		return k.validateRecipientModules(params)
+	}
+
+	oldParams, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	params, _, err = types.ApplyParamsUpdate(oldParams, params, mask.Paths)
+	if err != nil {
+		return errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	return k.validateRecipientModules(params)
+}
+
+// ScheduleParamsUpdate validates update against the current params and
+// appends it to the pending queue. It returns the assigned id.
+func (k Keeper) ScheduleParamsUpdate(ctx context.Context, update types.ScheduledParamsUpdate) (uint64, error) {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	if err := update.ValidateActivation(); err != nil {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+	if update.IsDue(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+		return 0, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, "activation must be in the future")
+	}
+
+	// Validate the update so that invalid params are rejected when they are
+	// scheduled rather than when they activate. The schedule guard depends on
+	// the supply and block time at activation, so it is only checked then,
+	// under the override of the update.
+	if err := k.validateParamsUpdate(ctx, update.Params, update.UpdateMask); err != nil {
+		return 0, err
+	}
+
+	id, err := k.ParamsUpdateSequence.Next(ctx)
+	if err != nil {
+		return 0, err
+	}
+	update.Id = id
+	update.ScheduledHeight = sdkCtx.BlockHeight()
+
+	if err := k.PendingParamsUpdates.Set(ctx, id, update); err != nil {
+		return 0, err
+	}
+
+	if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventParamsUpdateScheduled{
+		Update: update,
+	}); err != nil {
+		return 0, err
+	}
+	return id, nil
+}
+
+// applyDueParamsUpdates applies, in id order, every pending params update
+// whose activation is reached and removes it from the queue. An update that
+// is no longer valid against the current params is dropped and reported with
+// EventScheduledParamsUpdateFailed instead of halting the chain.
+func (k Keeper) applyDueParamsUpdates(ctx context.Context) error {
+	sdkCtx := sdk.UnwrapSDKContext(ctx)
+
+	var due []types.ScheduledParamsUpdate
+	if err := k.PendingParamsUpdates.Walk(ctx, nil, func(_ uint64, update types.ScheduledParamsUpdate) (bool, error) {
+		if update.IsDue(sdkCtx.BlockHeight(), sdkCtx.BlockTime()) {
+			due = append(due, update)
+		}
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, update := range due {
+		if err := k.PendingParamsUpdates.Remove(ctx, update.Id); err != nil {
+			return err
+		}
+
+		cacheCtx, write := sdkCtx.CacheContext()
+		if err := cacheCtx.EventManager().EmitTypedEvent(&types.EventScheduledParamsUpdateApplied{Id: update.Id}); err != nil {
+			return err
+		}
+		if err := k.applyParamsUpdate(cacheCtx, update.Params, update.UpdateMask, update.OverrideScheduleGuard); err != nil {
+			sdkCtx.Logger().Error("scheduled params update failed", "module", types.ModuleName, "id", update.Id, "err", err)
+			if err := sdkCtx.EventManager().EmitTypedEvent(&types.EventScheduledParamsUpdateFailed{
+				Id:    update.Id,
+				Error: err.Error(),
+			}); err != nil {
+				return err
+			}
+			continue
+		}
+		write()
+	}
+	return nil
+}
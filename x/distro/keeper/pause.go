@@ -0,0 +1,29 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// GetPauseStatus returns the minting pause status, or the unpaused status
+// when minting was never paused.
+func (k Keeper) GetPauseStatus(ctx context.Context) (types.PauseStatus, error) {
+	status, err := k.PauseStatus.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return types.PauseStatus{}, nil
+	}
+	return status, err
+}
+
+// IsPaused reports whether minting is paused.
+func (k Keeper) IsPaused(ctx context.Context) (bool, error) {
+	status, err := k.GetPauseStatus(ctx)
+	if err != nil {
+		return false, err
+	}
+	return status.Paused, nil
+}
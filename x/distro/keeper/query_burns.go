@@ -0,0 +1,68 @@
+package keeper
+
+import (
+	"context"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) BurnedSupply(ctx context.Context, req *types.QueryBurnedSupplyRequest) (*types.QueryBurnedSupplyResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	burned, err := q.k.GetBurnedSupply(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryBurnedSupplyResponse{BurnedSupply: burned}, nil
+}
+
+func (q queryServer) AddressBurns(ctx context.Context, req *types.QueryAddressBurnsRequest) (*types.QueryAddressBurnsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	burns, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.AddressBurned,
+		req.Pagination,
+		func(addr sdk.AccAddress, amount math.Int) (types.AddressBurned, error) {
+			addrStr, err := q.k.addressCodec.BytesToString(addr)
+			if err != nil {
+				return types.AddressBurned{}, err
+			}
+			return types.AddressBurned{Address: addrStr, Amount: amount}, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryAddressBurnsResponse{Burns: burns, Pagination: pageRes}, nil
+}
+
+func (q queryServer) AddressBurned(ctx context.Context, req *types.QueryAddressBurnedRequest) (*types.QueryAddressBurnedResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid address")
+	}
+
+	burned, err := q.k.GetAddressBurned(ctx, addr)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryAddressBurnedResponse{Amount: burned}, nil
+}
@@ -0,0 +1,58 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestBurnQueries(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, params, minter := setupMint(t, f)
+
+	res, err := qs.BurnedSupply(ctx, &types.QueryBurnedSupplyRequest{})
+	require.NoError(t, err)
+	require.True(t, res.BurnedSupply.IsZero())
+
+	_, err = ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+	_, err = ms.Burn(ctx, types.NewMsgBurn(params.ReceivingAddress, math.NewInt(300)))
+	require.NoError(t, err)
+	_, err = ms.Burn(ctx, types.NewMsgBurn(params.ReceivingAddress, math.NewInt(200)))
+	require.NoError(t, err)
+
+	res, err = qs.BurnedSupply(ctx, &types.QueryBurnedSupplyRequest{})
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(500), res.BurnedSupply)
+
+	burnedRes, err := qs.AddressBurned(ctx, &types.QueryAddressBurnedRequest{Address: params.ReceivingAddress})
+	require.NoError(t, err)
+	requireIntEqual(t, math.NewInt(500), burnedRes.Amount)
+
+	burnedRes, err = qs.AddressBurned(ctx, &types.QueryAddressBurnedRequest{Address: sample.AccAddress()})
+	require.NoError(t, err)
+	require.True(t, burnedRes.Amount.IsZero())
+
+	_, err = qs.AddressBurned(ctx, &types.QueryAddressBurnedRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+
+	burnsRes, err := qs.AddressBurns(ctx, &types.QueryAddressBurnsRequest{Pagination: &query.PageRequest{CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, burnsRes.Burns, 1)
+	require.Equal(t, params.ReceivingAddress, burnsRes.Burns[0].Address)
+	requireIntEqual(t, math.NewInt(500), burnsRes.Burns[0].Amount)
+	require.Equal(t, uint64(1), burnsRes.Pagination.Total)
+
+	_, err = qs.BurnedSupply(ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
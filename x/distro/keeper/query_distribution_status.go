@@ -0,0 +1,62 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) DistributionStatus(ctx context.Context, req *types.QueryDistributionStatusRequest) (*types.QueryDistributionStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "module params not initialized")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+	schedule, err := scheduleAt(params, blockTime)
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	currentSupply := q.k.bankKeeper.GetSupply(ctx, params.Denom).Amount
+
+	res := &types.QueryDistributionStatusResponse{
+		BlockTime:          blockTime,
+		CurrentPeriod:      schedule.HalvingPeriod,
+		DaysElapsed:        schedule.DaysElapsed,
+		DaysInPeriod:       schedule.DaysInPeriod,
+		PeriodLimit:        schedule.PeriodLimit,
+		TotalDistributable: schedule.TotalDistributable,
+		CurrentSupply:      currentSupply,
+	}
+	if schedule.HalvingPeriod != 0 {
+		res.PeriodStartDate = schedule.PeriodStart.Format("2006-01-02")
+		res.PeriodEndDate = schedule.PeriodEnd.Format("2006-01-02")
+	}
+
+	if res.MintedSupply, err = q.k.GetMintedSupply(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if res.Mintable, err = q.k.mintableAmount(ctx, params, schedule); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	if res.AutoMintWatermark, err = q.k.GetAutoMintWatermark(ctx); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,60 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestDistributionStatusQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	res, err := qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+	require.NoError(t, err)
+
+	periodLimit := types.DefaultMaxSupply.QuoRaw(2)
+	totalDistributable := periodLimit.MulRaw(184).QuoRaw(365)
+	require.Equal(t, ctx.BlockTime(), res.BlockTime)
+	require.Equal(t, uint64(1), res.CurrentPeriod)
+	require.Equal(t, "2025-07-22", res.PeriodStartDate)
+	require.Equal(t, "2026-07-21", res.PeriodEndDate)
+	require.Equal(t, uint64(184), res.DaysElapsed)
+	require.Equal(t, uint64(365), res.DaysInPeriod)
+	requireIntEqual(t, periodLimit, res.PeriodLimit)
+	requireIntEqual(t, totalDistributable, res.TotalDistributable)
+	requireIntEqual(t, math.NewInt(1_000), res.CurrentSupply)
+	requireIntEqual(t, math.NewInt(1_000), res.MintedSupply)
+	requireIntEqual(t, totalDistributable.SubRaw(1_000), res.Mintable)
+
+	// Minting the full headroom succeeds and leaves nothing mintable.
+	_, err = ms.Mint(ctx, types.NewMsgMint(res.Mintable, minter))
+	require.NoError(t, err)
+	res, err = qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+	require.NoError(t, err)
+	require.True(t, res.Mintable.IsZero())
+}
+
+func TestDistributionStatusQueryBeforeStart(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ctx := sdk.UnwrapSDKContext(f.ctx).WithBlockTime(time.Date(2025, 7, 21, 0, 0, 0, 0, time.UTC))
+
+	res, err := qs.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+	require.NoError(t, err)
+	require.Zero(t, res.CurrentPeriod)
+	require.Empty(t, res.PeriodStartDate)
+	require.True(t, res.TotalDistributable.IsZero())
+	require.True(t, res.Mintable.IsZero())
+}
@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) MintProposals(ctx context.Context, req *types.QueryMintProposalsRequest) (*types.QueryMintProposalsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposals, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.MintProposals,
+		req.Pagination,
+		func(_ uint64, proposal types.MintProposal) (types.MintProposal, error) {
+			return proposal, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintProposalsResponse{Proposals: proposals, Pagination: pageRes}, nil
+}
+
+func (q queryServer) MintProposal(ctx context.Context, req *types.QueryMintProposalRequest) (*types.QueryMintProposalResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	proposal, err := q.k.MintProposals.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "mint proposal not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryMintProposalResponse{Proposal: proposal}, nil
+}
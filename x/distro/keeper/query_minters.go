@@ -0,0 +1,72 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) Minters(ctx context.Context, req *types.QueryMintersRequest) (*types.QueryMintersResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	minters, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.Minters,
+		req.Pagination,
+		func(_ sdk.AccAddress, minter types.Minter) (types.Minter, error) {
+			return minter, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintersResponse{Minters: minters, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Minter(ctx context.Context, req *types.QueryMinterRequest) (*types.QueryMinterResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	addr, err := q.k.addressCodec.StringToBytes(req.Address)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid minter address")
+	}
+
+	minter, err := q.k.Minters.Get(ctx, addr)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "minter not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	schedule, err := scheduleAt(params, sdk.UnwrapSDKContext(ctx).BlockTime())
+	if err != nil {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
+
+	res := &types.QueryMinterResponse{Minter: minter}
+	if res.MintedCurrentPeriod, err = q.k.GetMinterUsage(ctx, addr, schedule.HalvingPeriod); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if res.MintedTotal, err = q.k.GetMintedTotal(ctx, addr); err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return res, nil
+}
@@ -0,0 +1,65 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/collections"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMintersQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	for i := 0; i < 5; i++ {
+		addMinter(t, f, types.NewMinterQuota(math.LegacyNewDecWithPrec(1, 1), math.NewInt(int64(i))))
+	}
+
+	res, err := qs.Minters(f.ctx, &types.QueryMintersRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+	require.NoError(t, err)
+	require.Len(t, res.Minters, 2)
+	require.Equal(t, uint64(5), res.Pagination.Total)
+
+	res, err = qs.Minters(f.ctx, &types.QueryMintersRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+	require.NoError(t, err)
+	require.Len(t, res.Minters, 3)
+
+	_, err = qs.Minters(f.ctx, nil)
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
+
+func TestMinterQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+	ms := keeper.NewMsgServerImpl(f.keeper)
+	ctx, _, minter := setupMint(t, f)
+
+	_, err := ms.Mint(ctx, types.NewMsgMint(math.NewInt(1_000), minter))
+	require.NoError(t, err)
+
+	// Usage from an earlier period only counts towards the total.
+	addr, err := f.addressCodec.StringToBytes(minter)
+	require.NoError(t, err)
+	require.NoError(t, f.keeper.MinterUsage.Set(ctx, collections.Join(sdk.AccAddress(addr), uint64(0)), math.NewInt(500)))
+
+	res, err := qs.Minter(ctx, &types.QueryMinterRequest{Address: minter})
+	require.NoError(t, err)
+	require.Equal(t, types.NewMinter(minter, types.UnlimitedMinterQuota()), res.Minter)
+	requireIntEqual(t, math.NewInt(1_000), res.MintedCurrentPeriod)
+	requireIntEqual(t, math.NewInt(1_500), res.MintedTotal)
+
+	_, err = qs.Minter(ctx, &types.QueryMinterRequest{Address: sample.AccAddress()})
+	require.Equal(t, codes.NotFound, status.Code(err))
+
+	_, err = qs.Minter(ctx, &types.QueryMinterRequest{Address: "invalid"})
+	require.Equal(t, codes.InvalidArgument, status.Code(err))
+}
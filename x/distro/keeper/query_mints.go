@@ -0,0 +1,83 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) Mints(ctx context.Context, req *types.QueryMintsRequest) (*types.QueryMintsResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	var (
+		mints   []types.MintRecord
+		pageRes *query.PageResponse
+		err     error
+	)
+
+	switch {
+	case req.Signer != "":
+		signer, addrErr := q.k.addressCodec.StringToBytes(req.Signer)
+		if addrErr != nil {
+			return nil, status.Error(codes.InvalidArgument, "invalid signer address")
+		}
+		mints, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.MintsBySigner,
+			req.Pagination,
+			func(key collections.Pair[sdk.AccAddress, uint64], _ collections.NoValue) (types.MintRecord, error) {
+				return q.k.Mints.Get(ctx, key.K2())
+			},
+			query.WithCollectionPaginationPairPrefix[sdk.AccAddress, uint64](signer),
+		)
+	case req.BlockHeight != 0:
+		mints, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.MintsByHeight,
+			req.Pagination,
+			func(key collections.Pair[int64, uint64], _ collections.NoValue) (types.MintRecord, error) {
+				return q.k.Mints.Get(ctx, key.K2())
+			},
+			query.WithCollectionPaginationPairPrefix[int64, uint64](req.BlockHeight),
+		)
+	default:
+		mints, pageRes, err = query.CollectionPaginate(
+			ctx,
+			q.k.Mints,
+			req.Pagination,
+			func(_ uint64, record types.MintRecord) (types.MintRecord, error) {
+				return record, nil
+			},
+		)
+	}
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryMintsResponse{Mints: mints, Pagination: pageRes}, nil
+}
+
+func (q queryServer) Mint(ctx context.Context, req *types.QueryMintRequest) (*types.QueryMintResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	mint, err := q.k.Mints.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "mint not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryMintResponse{Mint: mint}, nil
+}
@@ -0,0 +1,89 @@
+package keeper_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestMintsQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	signerA, signerB := sample.AccAddress(), sample.AccAddress()
+	signers := []string{signerA, signerB, signerA, signerA, signerB}
+	records := make([]types.MintRecord, len(signers))
+	for i, signer := range signers {
+		id, err := f.keeper.AppendMint(f.ctx, types.MintRecord{
+			Signer:      signer,
+			Recipient:   sample.AccAddress(),
+			Amount:      math.NewInt(int64(i + 1)),
+			Denom:       types.DefaultDenom,
+			BlockHeight: int64(10 + i/2),
+			BlockTime:   time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+		})
+		require.NoError(t, err)
+		records[i], err = f.keeper.Mints.Get(f.ctx, id)
+		require.NoError(t, err)
+	}
+
+	t.Run("all mints paginated", func(t *testing.T) {
+		res, err := qs.Mints(f.ctx, &types.QueryMintsRequest{Pagination: &query.PageRequest{Limit: 2, CountTotal: true}})
+		require.NoError(t, err)
+		require.Equal(t, records[:2], res.Mints)
+		require.Equal(t, uint64(len(records)), res.Pagination.Total)
+
+		res, err = qs.Mints(f.ctx, &types.QueryMintsRequest{Pagination: &query.PageRequest{Key: res.Pagination.NextKey}})
+		require.NoError(t, err)
+		require.Equal(t, records[2:], res.Mints)
+	})
+
+	t.Run("filtered by signer", func(t *testing.T) {
+		res, err := qs.Mints(f.ctx, &types.QueryMintsRequest{Signer: signerA})
+		require.NoError(t, err)
+		require.Equal(t, []types.MintRecord{records[0], records[2], records[3]}, res.Mints)
+	})
+
+	t.Run("filtered by block height", func(t *testing.T) {
+		res, err := qs.Mints(f.ctx, &types.QueryMintsRequest{BlockHeight: 11})
+		require.NoError(t, err)
+		require.Equal(t, records[2:4], res.Mints)
+	})
+
+	t.Run("invalid signer", func(t *testing.T) {
+		_, err := qs.Mints(f.ctx, &types.QueryMintsRequest{Signer: "invalid"})
+		require.Equal(t, codes.InvalidArgument, status.Code(err))
+	})
+}
+
+func TestMintQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	record := types.MintRecord{
+		Signer:    sample.AccAddress(),
+		Recipient: sample.AccAddress(),
+		Amount:    math.NewInt(1_000),
+		Denom:     types.DefaultDenom,
+		BlockTime: time.Date(2025, 8, 1, 0, 0, 0, 0, time.UTC),
+	}
+	id, err := f.keeper.AppendMint(f.ctx, record)
+	require.NoError(t, err)
+	record.Id = id
+
+	res, err := qs.Mint(f.ctx, &types.QueryMintRequest{Id: id})
+	require.NoError(t, err)
+	require.Equal(t, record, res.Mint)
+
+	_, err = qs.Mint(f.ctx, &types.QueryMintRequest{Id: id + 1})
+	require.Equal(t, codes.NotFound, status.Code(err))
+}
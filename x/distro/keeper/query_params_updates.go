@@ -0,0 +1,49 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	"github.com/cosmos/cosmos-sdk/types/query"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) PendingParamsUpdates(ctx context.Context, req *types.QueryPendingParamsUpdatesRequest) (*types.QueryPendingParamsUpdatesResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	updates, pageRes, err := query.CollectionPaginate(
+		ctx,
+		q.k.PendingParamsUpdates,
+		req.Pagination,
+		func(_ uint64, update types.ScheduledParamsUpdate) (types.ScheduledParamsUpdate, error) {
+			return update, nil
+		},
+	)
+	if err != nil {
+		return nil, status.Error(codes.Internal, err.Error())
+	}
+
+	return &types.QueryPendingParamsUpdatesResponse{Updates: updates, Pagination: pageRes}, nil
+}
+
+func (q queryServer) PendingParamsUpdate(ctx context.Context, req *types.QueryPendingParamsUpdateRequest) (*types.QueryPendingParamsUpdateResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	update, err := q.k.PendingParamsUpdates.Get(ctx, req.Id)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "params update not found")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPendingParamsUpdateResponse{Update: update}, nil
+}
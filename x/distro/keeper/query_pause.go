@@ -0,0 +1,23 @@
+package keeper
+
+import (
+	"context"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func (q queryServer) PauseStatus(ctx context.Context, req *types.QueryPauseStatusRequest) (*types.QueryPauseStatusResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	pauseStatus, err := q.k.GetPauseStatus(ctx)
+	if err != nil {
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+
+	return &types.QueryPauseStatusResponse{Status: pauseStatus}, nil
+}
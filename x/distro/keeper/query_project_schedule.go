@@ -0,0 +1,126 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"cosmossdk.io/collections"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// maxProjectionPoints bounds the number of points a single ProjectSchedule
+// query may return.
+const maxProjectionPoints = 5000
+
+func (q queryServer) ProjectSchedule(ctx context.Context, req *types.QueryProjectScheduleRequest) (*types.QueryProjectScheduleResponse, error) {
+	if req == nil {
+		return nil, status.Error(codes.InvalidArgument, "invalid request")
+	}
+
+	startDate, err := parseDate(req.StartDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "start date must be in YYYY-MM-DD format")
+	}
+	endDate, err := parseDate(req.EndDate)
+	if err != nil {
+		return nil, status.Error(codes.InvalidArgument, "end date must be in YYYY-MM-DD format")
+	}
+	if endDate.Before(startDate) {
+		return nil, status.Error(codes.InvalidArgument, "end date is before start date")
+	}
+
+	params, err := q.k.Params.Get(ctx)
+	if err != nil {
+		if errors.Is(err, collections.ErrNotFound) {
+			return nil, status.Error(codes.NotFound, "module params not initialized")
+		}
+		return nil, status.Error(codes.Internal, "internal error")
+	}
+	if o := req.Override; o != nil {
+		if !o.MaxSupply.IsNil() && !o.MaxSupply.IsZero() {
+			params.MaxSupply = o.MaxSupply
+		}
+		if o.DistributionStartDate != "" {
+			params.DistributionStartDate = o.DistributionStartDate
+		}
+		if o.MonthsInHalvingPeriod != 0 {
+			params.MonthsInHalvingPeriod = o.MonthsInHalvingPeriod
+		}
+		if o.EmissionCurve != nil {
+			params.EmissionCurve = *o.EmissionCurve
+		}
+		if err := params.EmissionCurve.Validate(params.MaxSupply, params.DistributionStartDate); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+	}
+
+	var next func(date time.Time) (time.Time, error)
+	switch req.Granularity {
+	case types.ProjectionGranularity_PROJECTION_GRANULARITY_UNSPECIFIED, types.ProjectionGranularity_PROJECTION_GRANULARITY_DAY:
+		next = func(date time.Time) (time.Time, error) { return date.AddDate(0, 0, 1), nil }
+	case types.ProjectionGranularity_PROJECTION_GRANULARITY_MONTH:
+		months := 0
+		next = func(time.Time) (time.Time, error) {
+			months++
+			return addMonths(startDate, months), nil
+		}
+	case types.ProjectionGranularity_PROJECTION_GRANULARITY_PERIOD:
+		next = func(date time.Time) (time.Time, error) { return nextPeriodStart(params, date) }
+	default:
+		return nil, status.Error(codes.InvalidArgument, "unknown granularity")
+	}
+
+	var points []types.SchedulePoint
+	for date := startDate; ; {
+		if len(points) == maxProjectionPoints {
+			return nil, status.Errorf(codes.InvalidArgument, "projection exceeds %d points", maxProjectionPoints)
+		}
+
+		state, err := scheduleAt(params, date)
+		if err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		points = append(points, types.SchedulePoint{
+			Date:               date.Format("2006-01-02"),
+			HalvingPeriod:      state.HalvingPeriod,
+			TotalDistributable: state.TotalDistributable,
+		})
+		if date.Equal(endDate) {
+			break
+		}
+
+		if date, err = next(date); err != nil {
+			return nil, status.Error(codes.InvalidArgument, err.Error())
+		}
+		if date.After(endDate) {
+			date = endDate
+		}
+	}
+
+	return &types.QueryProjectScheduleResponse{Points: points}, nil
+}
+
+// nextPeriodStart returns the first day of the halving period following the
+// one date falls in, or the distribution start date if date precedes it.
+func nextPeriodStart(params types.Params, date time.Time) (time.Time, error) {
+	state, err := scheduleAt(params, date)
+	if err != nil {
+		return time.Time{}, err
+	}
+	next := state.PeriodEnd.AddDate(0, 0, 1)
+	if state.HalvingPeriod == 0 {
+		if next, err = types.ParseScheduleTime(params.DistributionStartDate); err != nil {
+			return time.Time{}, err
+		}
+	}
+	// Points are whole days, while the period may start within a day.
+	next = next.Truncate(24 * time.Hour)
+	if !next.After(date) {
+		next = next.AddDate(0, 0, 1)
+	}
+	return next, nil
+}
@@ -0,0 +1,87 @@
+package keeper_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestProjectScheduleQuery(t *testing.T) {
+	f := initFixture(t)
+	qs := keeper.NewQueryServerImpl(f.keeper)
+
+	maxSupply := types.DefaultMaxSupply
+
+	t.Run("daily", func(t *testing.T) {
+		res, err := qs.ProjectSchedule(f.ctx, &types.QueryProjectScheduleRequest{
+			StartDate: "2025-07-21",
+			EndDate:   "2025-07-23",
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.SchedulePoint{
+			{Date: "2025-07-21", HalvingPeriod: 0, TotalDistributable: math.ZeroInt()},
+			{Date: "2025-07-22", HalvingPeriod: 1, TotalDistributable: math.ZeroInt()},
+			{Date: "2025-07-23", HalvingPeriod: 1, TotalDistributable: maxSupply.QuoRaw(2).QuoRaw(365)},
+		}, res.Points)
+	})
+
+	t.Run("monthly ends on end date", func(t *testing.T) {
+		res, err := qs.ProjectSchedule(f.ctx, &types.QueryProjectScheduleRequest{
+			StartDate:   "2025-07-22",
+			EndDate:     "2025-10-01",
+			Granularity: types.ProjectionGranularity_PROJECTION_GRANULARITY_MONTH,
+		})
+		require.NoError(t, err)
+		dates := make([]string, len(res.Points))
+		for i, p := range res.Points {
+			dates[i] = p.Date
+		}
+		require.Equal(t, []string{"2025-07-22", "2025-08-22", "2025-09-22", "2025-10-01"}, dates)
+	})
+
+	t.Run("per period", func(t *testing.T) {
+		res, err := qs.ProjectSchedule(f.ctx, &types.QueryProjectScheduleRequest{
+			StartDate:   "2025-01-01",
+			EndDate:     "2027-07-22",
+			Granularity: types.ProjectionGranularity_PROJECTION_GRANULARITY_PERIOD,
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.SchedulePoint{
+			{Date: "2025-01-01", HalvingPeriod: 0, TotalDistributable: math.ZeroInt()},
+			{Date: "2025-07-22", HalvingPeriod: 1, TotalDistributable: math.ZeroInt()},
+			{Date: "2026-07-22", HalvingPeriod: 2, TotalDistributable: maxSupply.QuoRaw(2)},
+			{Date: "2027-07-22", HalvingPeriod: 3, TotalDistributable: maxSupply.QuoRaw(2).Add(maxSupply.QuoRaw(4))},
+		}, res.Points)
+	})
+
+	t.Run("with override", func(t *testing.T) {
+		res, err := qs.ProjectSchedule(f.ctx, &types.QueryProjectScheduleRequest{
+			StartDate:   "2026-07-22",
+			EndDate:     "2026-07-22",
+			Granularity: types.ProjectionGranularity_PROJECTION_GRANULARITY_PERIOD,
+			Override:    &types.ScheduleOverride{MonthsInHalvingPeriod: 24},
+		})
+		require.NoError(t, err)
+		require.Equal(t, []types.SchedulePoint{
+			{Date: "2026-07-22", HalvingPeriod: 1, TotalDistributable: maxSupply.QuoRaw(2).MulRaw(365).QuoRaw(730)},
+		}, res.Points)
+	})
+
+	t.Run("invalid requests", func(t *testing.T) {
+		for _, req := range []*types.QueryProjectScheduleRequest{
+			{StartDate: "2025-07-22", EndDate: "22-07-2025"},
+			{StartDate: "2025-07-22", EndDate: "2025-07-21"},
+			{StartDate: "2025-07-22", EndDate: "2045-07-22"},
+			{StartDate: "2025-07-22", EndDate: "2025-07-22", Override: &types.ScheduleOverride{DistributionStartDate: "bad"}},
+		} {
+			_, err := qs.ProjectSchedule(f.ctx, req)
+			require.Equal(t, codes.InvalidArgument, status.Code(err), req.String())
+		}
+	})
+}
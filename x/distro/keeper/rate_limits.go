@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// checkMintRateLimits returns an error if a MsgMint of amount in the current
+// block would exceed the per-mint limit, the rolling window limit or the
+// minimum interval between mints of params. The limits apply to all minters
+// together.
+func (k Keeper) checkMintRateLimits(ctx sdk.Context, params types.Params, amount math.Int) error {
+	if maxAmount := params.MaxMintAmount; !maxAmount.IsNil() && maxAmount.IsPositive() && amount.GT(maxAmount) {
+		return errorsmod.Wrapf(types.ErrMintAmountTooLarge, "amount %s exceeds the per-mint limit of %s", amount, maxAmount)
+	}
+
+	if err := k.pruneRecentMints(ctx, params); err != nil {
+		return err
+	}
+
+	blockTime := ctx.BlockTime()
+	windowStart := blockTime.Add(-params.MintWindow)
+	windowTotal := math.ZeroInt()
+	var lastMint time.Time
+	if err := k.RecentMints.Walk(ctx, nil, func(_ uint64, recent types.RecentMint) (bool, error) {
+		if recent.BlockTime.After(windowStart) {
+			windowTotal = windowTotal.Add(recent.Amount)
+		}
+		lastMint = recent.BlockTime
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	if params.MinMintInterval > 0 && !lastMint.IsZero() && blockTime.Sub(lastMint) < params.MinMintInterval {
+		return errorsmod.Wrapf(types.ErrMintTooFrequent, "last mint at %s, next mint allowed at %s", lastMint, lastMint.Add(params.MinMintInterval))
+	}
+
+	if params.MintWindow > 0 && windowTotal.Add(amount).GT(params.MaxWindowAmount) {
+		return errorsmod.Wrapf(types.ErrMintWindowExceeded, "%s already minted in the last %s, limit is %s", windowTotal, params.MintWindow, params.MaxWindowAmount)
+	}
+
+	return nil
+}
+
+// recordRecentMint records the MsgMint with the given ledger id so that it
+// counts towards the rate limits of params.
+func (k Keeper) recordRecentMint(ctx sdk.Context, params types.Params, id uint64, amount math.Int) error {
+	if params.MintWindow == 0 && params.MinMintInterval == 0 {
+		return nil
+	}
+	return k.RecentMints.Set(ctx, id, types.RecentMint{
+		Id:        id,
+		BlockTime: ctx.BlockTime(),
+		Amount:    amount,
+	})
+}
+
+// pruneRecentMints removes the recent mints that count towards neither the
+// rolling window nor the minimum interval of params anymore.
+func (k Keeper) pruneRecentMints(ctx sdk.Context, params types.Params) error {
+	cutoff := ctx.BlockTime().Add(-max(params.MintWindow, params.MinMintInterval))
+
+	var expired []uint64
+	if err := k.RecentMints.Walk(ctx, nil, func(id uint64, recent types.RecentMint) (bool, error) {
+		// Mints are keyed by ledger id, so they are ordered by block time.
+		if recent.BlockTime.After(cutoff) {
+			return true, nil
+		}
+		expired = append(expired, id)
+		return false, nil
+	}); err != nil {
+		return err
+	}
+
+	for _, id := range expired {
+		if err := k.RecentMints.Remove(ctx, id); err != nil {
+			return err
+		}
+	}
+	return nil
+}
@@ -0,0 +1,128 @@
+package keeper
+
+import (
+	"bytes"
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	distrtypes "github.com/cosmos/cosmos-sdk/x/distribution/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// distributeCoins sends amount freshly minted coins from the module account to
+// the weighted recipients in params. The rounding dust, or the whole amount
+// when no recipients are configured, goes to the receiving address.
+func (k Keeper) distributeCoins(ctx context.Context, params types.Params, amount math.Int) ([]types.Payout, error) {
+	parts, dust := types.SplitAmount(params.Recipients, amount)
+
+	var payouts []types.Payout
+	for i, recipient := range params.Recipients {
+		if parts[i].IsZero() {
+			continue
+		}
+		addr, err := k.sendToRecipient(ctx, recipient, sdk.NewCoins(sdk.NewCoin(params.Denom, parts[i])))
+		if err != nil {
+			return nil, err
+		}
+		payouts = append(payouts, types.Payout{Address: addr, Amount: parts[i]})
+	}
+
+	if dust.IsPositive() {
+		addr, err := k.sendToRecipient(ctx, types.Recipient{Address: params.ReceivingAddress}, sdk.NewCoins(sdk.NewCoin(params.Denom, dust)))
+		if err != nil {
+			return nil, err
+		}
+		payouts = append(payouts, types.Payout{Address: addr, Amount: dust})
+	}
+
+	return payouts, nil
+}
+
+// sendToRecipient sends coins from the module account to the recipient and
+// returns the address of the account that received them. Coins for the
+// x/distribution module account are deposited into the community pool so that
+// its accounting stays consistent.
+func (k Keeper) sendToRecipient(ctx context.Context, recipient types.Recipient, coins sdk.Coins) (string, error) {
+	if recipient.Module == "" {
+		addr, err := k.addressCodec.StringToBytes(recipient.Address)
+		if err != nil {
+			return "", errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid address '%s'", recipient.Address)
+		}
+		if err := k.bankKeeper.SendCoinsFromModuleToAccount(ctx, types.ModuleName, addr, coins); err != nil {
+			return "", err
+		}
+		return recipient.Address, nil
+	}
+
+	moduleAddr := k.accountKeeper.GetModuleAddress(recipient.Module)
+	if moduleAddr == nil {
+		return "", errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.Module)
+	}
+	addr, err := k.addressCodec.BytesToString(moduleAddr)
+	if err != nil {
+		return "", err
+	}
+
+	if recipient.Module == distrtypes.ModuleName {
+		err = k.distributionKeeper.FundCommunityPool(ctx, coins, k.accountKeeper.GetModuleAddress(types.ModuleName))
+	} else {
+		err = k.bankKeeper.SendCoinsFromModuleToModule(ctx, types.ModuleName, recipient.Module, coins)
+	}
+	if err != nil {
+		return "", err
+	}
+	return addr, nil
+}
+
+// validateMintDestination checks that recipient, when set, is one of the
+// mint destinations of params, and that reference is not too long.
+func (k Keeper) validateMintDestination(params types.Params, recipient, reference string) error {
+	if len(reference) > types.MaxMintReferenceLength {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "reference cannot be longer than %d bytes", types.MaxMintReferenceLength)
+	}
+	if recipient == "" {
+		return nil
+	}
+
+	recipientBytes, err := k.addressCodec.StringToBytes(recipient)
+	if err != nil {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidAddress, "invalid recipient address '%s'", recipient)
+	}
+	for _, destination := range params.MintDestinations {
+		destinationBytes, err := k.addressCodec.StringToBytes(destination)
+		if err != nil {
+			return err
+		}
+		if bytes.Equal(destinationBytes, recipientBytes) {
+			return nil
+		}
+	}
+	return errorsmod.Wrapf(types.ErrRecipientNotAllowed, "%s", recipient)
+}
+
+// validateRecipientModules checks that every module recipient in params refers
+// to an existing module account that may receive the mints. The module
+// account of x/distro must stay empty, and the accounts blocked by x/bank,
+// such as the staking pools, hold balances that their modules account for.
+// The x/distribution module account is blocked too, but its mints are
+// deposited into the community pool.
+func (k Keeper) validateRecipientModules(params types.Params) error {
+	for _, recipient := range params.Recipients {
+		if recipient.Module == "" {
+			continue
+		}
+		moduleAddr := k.accountKeeper.GetModuleAddress(recipient.Module)
+		if moduleAddr == nil {
+			return errorsmod.Wrapf(sdkerrors.ErrUnknownAddress, "module account %s does not exist", recipient.Module)
+		}
+		if recipient.Module == types.ModuleName ||
+			(recipient.Module != distrtypes.ModuleName && k.bankKeeper.BlockedAddr(moduleAddr)) {
+			return errorsmod.Wrapf(sdkerrors.ErrUnauthorized, "module account %s is not allowed to receive mints", recipient.Module)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,71 @@
+package keeper
+
+import (
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// scheduleState describes the distribution schedule on a given date.
+type scheduleState struct {
+	// HalvingPeriod is the 1-based period the date falls in. Periods are the
+	// accounting periods of minter quotas whatever the emission curve.
+	HalvingPeriod uint64
+	// PeriodStart and PeriodEnd are the first and last day of the period.
+	PeriodStart time.Time
+	PeriodEnd   time.Time
+	// DaysElapsed is the number of whole days elapsed in the period and
+	// DaysInPeriod the total number of days it spans.
+	DaysElapsed  uint64
+	DaysInPeriod uint64
+	// PeriodLimit is the amount distributable over the whole period.
+	PeriodLimit math.Int
+	// TotalDistributable is the cumulative distributable cap on the date.
+	TotalDistributable math.Int
+}
+
+// scheduleAt computes the schedule state at blockTime under the emission
+// curve of params. With day precision, the block time is truncated to a whole
+// number of days since the distribution start, so the allowance of a day
+// unlocks at once; with second precision it is truncated to the second.
+// Before the distribution start the zero state, whose HalvingPeriod is 0, is
+// returned.
+func scheduleAt(params types.Params, blockTime time.Time) (scheduleState, error) {
+	startDate, err := types.ParseScheduleTime(params.DistributionStartDate)
+	if err != nil {
+		return scheduleState{}, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid distribution start date: %v", err)
+	}
+
+	blockTime = blockTime.UTC()
+	if blockTime.Before(startDate) {
+		return scheduleState{PeriodLimit: math.ZeroInt(), TotalDistributable: math.ZeroInt()}, nil
+	}
+
+	at := blockTime.Truncate(time.Second)
+	if params.SchedulePrecision == types.SchedulePrecision_SCHEDULE_PRECISION_DAY {
+		at = startDate.Add(blockTime.Sub(startDate).Truncate(24 * time.Hour))
+	}
+
+	curve, err := newEmissionCurve(params, startDate)
+	if err != nil {
+		return scheduleState{}, errorsmod.Wrap(sdkerrors.ErrInvalidRequest, err.Error())
+	}
+
+	var (
+		state           scheduleState
+		nextPeriodStart time.Time
+	)
+	state.HalvingPeriod, state.PeriodStart, nextPeriodStart = periodAt(startDate, params.MonthsInHalvingPeriod, at)
+	state.PeriodEnd = nextPeriodStart.AddDate(0, 0, -1)
+	state.DaysInPeriod = daysBetween(state.PeriodStart, nextPeriodStart)
+	state.DaysElapsed = daysBetween(state.PeriodStart, at)
+
+	state.TotalDistributable = curve.totalDistributable(at)
+	state.PeriodLimit = curve.totalDistributable(nextPeriodStart).Sub(curve.totalDistributable(state.PeriodStart))
+
+	return state, nil
+}
@@ -0,0 +1,47 @@
+package keeper
+
+import (
+	"context"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// validateScheduleChange rejects a change from oldParams to newParams that
+// rewrites the distribution schedule at the current block time, either by
+// lowering the distributable amount below the supply already minted by the
+// module, which would brick minting and break the supply invariants, or,
+// unless override is set, by unlocking more than the max unlock jump of
+// oldParams at once. The jump cap of the current params applies so that a
+// change cannot lift the cap and use it in the same step.
+func (k Keeper) validateScheduleChange(ctx context.Context, oldParams, newParams types.Params, override bool) error {
+	blockTime := sdk.UnwrapSDKContext(ctx).BlockTime()
+
+	oldSchedule, err := scheduleAt(oldParams, blockTime)
+	if err != nil {
+		return err
+	}
+	newSchedule, err := scheduleAt(newParams, blockTime)
+	if err != nil {
+		return err
+	}
+
+	minted, err := k.GetMintedSupply(ctx)
+	if err != nil {
+		return err
+	}
+	oldDistributable, newDistributable := oldSchedule.TotalDistributable, newSchedule.TotalDistributable
+	if minted.GT(newDistributable) {
+		return errorsmod.Wrapf(types.ErrScheduleGuard, "minted supply %s exceeds the %s distributable under the new params", minted, newDistributable)
+	}
+
+	if newDistributable.GT(oldDistributable) {
+		jump := newDistributable.Sub(oldDistributable)
+		if maxJump := oldParams.MaxUnlockJump; !override && !maxJump.IsNil() && maxJump.IsPositive() && jump.GT(maxJump) {
+			return errorsmod.Wrapf(types.ErrScheduleGuard, "change unlocks %s at once, above the max unlock jump of %s", jump, maxJump)
+		}
+	}
+	return nil
+}
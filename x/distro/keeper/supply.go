@@ -0,0 +1,106 @@
+package keeper
+
+import (
+	"context"
+	"errors"
+
+	"cosmossdk.io/collections"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// GetMintedSupply returns the cumulative amount minted by the module.
+func (k Keeper) GetMintedSupply(ctx context.Context) (math.Int, error) {
+	minted, err := k.MintedSupply.Get(ctx)
+	if errors.Is(err, collections.ErrNotFound) {
+		return math.ZeroInt(), nil
+	}
+	return minted, err
+}
+
+// addMintedSupply adds amount to the cumulative amount minted by the module.
+func (k Keeper) addMintedSupply(ctx context.Context, amount math.Int) error {
+	minted, err := k.GetMintedSupply(ctx)
+	if err != nil {
+		return err
+	}
+	return k.MintedSupply.Set(ctx, minted.Add(amount))
+}
+
+// AddUnrecordedMintedSupply adds amount, minted by the module before it
+// recorded its mints, to the minted supply that Migrate3to4 seeds from the
+// mint ledger. It is meant for the upgrade handler that runs the migration.
+// The minted supply cannot exceed the bank supply of the denom.
+func (k Keeper) AddUnrecordedMintedSupply(ctx context.Context, amount math.Int) error {
+	if amount.IsNil() || amount.IsNegative() {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid unrecorded minted supply %s", amount)
+	}
+
+	params, err := k.Params.Get(ctx)
+	if err != nil {
+		return err
+	}
+	minted, err := k.GetMintedSupply(ctx)
+	if err != nil {
+		return err
+	}
+	minted = minted.Add(amount)
+	if supply := k.bankKeeper.GetSupply(ctx, params.Denom).Amount; minted.GT(supply) {
+		return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "minted supply %s exceeds the bank supply %s", minted, supply)
+	}
+	return k.MintedSupply.Set(ctx, minted)
+}
+
+// maxSupplyBasis returns the supply of denom that the max supply is checked
+// against, as selected by the max supply basis of params. Coins burned
+// through the module still count towards the max supply unless params allow
+// burns to reopen it. Burns never reopen the distribution schedule, which
+// always caps the cumulative minted supply.
+func (k Keeper) maxSupplyBasis(ctx context.Context, params types.Params) (math.Int, error) {
+	if params.MaxSupplyBasis == types.SupplyBasis_SUPPLY_BASIS_DISTRO {
+		minted, err := k.GetMintedSupply(ctx)
+		if err != nil || !params.BurnsReopenMaxSupply {
+			return minted, err
+		}
+		burned, err := k.GetBurnedSupply(ctx)
+		if err != nil {
+			return math.Int{}, err
+		}
+		// Holders may also burn coins that were not minted by the module.
+		return math.MaxInt(minted.Sub(burned), math.ZeroInt()), nil
+	}
+
+	// Burns already lower the bank supply.
+	supply := k.bankKeeper.GetSupply(ctx, params.Denom).Amount
+	if params.BurnsReopenMaxSupply {
+		return supply, nil
+	}
+	burned, err := k.GetBurnedSupply(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	return supply.Add(burned), nil
+}
+
+// mintableAmount returns the amount that can still be minted under both the
+// distribution schedule, which caps the amount minted by the module, and the
+// max supply.
+func (k Keeper) mintableAmount(ctx context.Context, params types.Params, schedule scheduleState) (math.Int, error) {
+	minted, err := k.GetMintedSupply(ctx)
+	if err != nil {
+		return math.Int{}, err
+	}
+	basis, err := k.maxSupplyBasis(ctx, params)
+	if err != nil {
+		return math.Int{}, err
+	}
+
+	mintable := math.MinInt(schedule.TotalDistributable.Sub(minted), params.MaxSupply.Sub(basis))
+	if mintable.IsNegative() {
+		return math.ZeroInt(), nil
+	}
+	return mintable, nil
+}
@@ -0,0 +1,87 @@
+package keeper
+
+import (
+	"context"
+	"slices"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	authtypes "github.com/cosmos/cosmos-sdk/x/auth/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// setupVestingAccount makes addr a vesting account that vests coins, which
+// were just sent to it, on the schedule of msg. A missing account is created,
+// and a plain account is converted while the rest of its balance stays
+// spendable. An existing vesting account is funded only if it has the same
+// type and schedule.
+func (k Keeper) setupVestingAccount(ctx context.Context, addr sdk.AccAddress, msg *types.MsgMintVesting, coins sdk.Coins) error {
+	acc := k.accountKeeper.GetAccount(ctx, addr)
+	if acc == nil {
+		acc = k.accountKeeper.NewAccount(ctx, authtypes.NewBaseAccountWithAddress(addr))
+	}
+
+	switch acc := acc.(type) {
+	case *authtypes.BaseAccount:
+		vestingAcc, err := newVestingAccount(acc, msg, coins)
+		if err != nil {
+			return err
+		}
+		k.accountKeeper.SetAccount(ctx, vestingAcc)
+		return nil
+
+	case *vestingtypes.ContinuousVestingAccount:
+		if msg.VestingType == types.VestingType_VESTING_TYPE_CONTINUOUS &&
+			acc.StartTime == msg.StartTime && acc.EndTime == msg.EndTime {
+			acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+			k.accountKeeper.SetAccount(ctx, acc)
+			return nil
+		}
+
+	case *vestingtypes.DelayedVestingAccount:
+		if msg.VestingType == types.VestingType_VESTING_TYPE_DELAYED && acc.EndTime == msg.EndTime {
+			acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+			k.accountKeeper.SetAccount(ctx, acc)
+			return nil
+		}
+
+	case *vestingtypes.PeriodicVestingAccount:
+		if msg.VestingType == types.VestingType_VESTING_TYPE_PERIODIC && acc.StartTime == msg.StartTime &&
+			slices.EqualFunc(acc.VestingPeriods, msg.Periods, func(a, b vestingtypes.Period) bool { return a.Length == b.Length }) {
+			for i := range acc.VestingPeriods {
+				acc.VestingPeriods[i].Amount = acc.VestingPeriods[i].Amount.Add(msg.Periods[i].Amount...)
+			}
+			acc.OriginalVesting = acc.OriginalVesting.Add(coins...)
+			k.accountKeeper.SetAccount(ctx, acc)
+			return nil
+		}
+	}
+
+	return errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "account %s cannot be funded with this vesting schedule", addr)
+}
+
+// newVestingAccount returns a vesting account of the type of msg wrapping
+// base, that vests coins.
+func newVestingAccount(base *authtypes.BaseAccount, msg *types.MsgMintVesting, coins sdk.Coins) (sdk.AccountI, error) {
+	switch msg.VestingType {
+	case types.VestingType_VESTING_TYPE_CONTINUOUS:
+		baseVesting, err := vestingtypes.NewBaseVestingAccount(base, coins, msg.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		return vestingtypes.NewContinuousVestingAccountRaw(baseVesting, msg.StartTime), nil
+	case types.VestingType_VESTING_TYPE_DELAYED:
+		baseVesting, err := vestingtypes.NewBaseVestingAccount(base, coins, msg.EndTime)
+		if err != nil {
+			return nil, err
+		}
+		return vestingtypes.NewDelayedVestingAccountRaw(baseVesting), nil
+	case types.VestingType_VESTING_TYPE_PERIODIC:
+		return vestingtypes.NewPeriodicVestingAccount(base, coins, msg.StartTime, msg.Periods)
+	default:
+		return nil, errorsmod.Wrapf(sdkerrors.ErrInvalidRequest, "invalid vesting type %d", msg.VestingType)
+	}
+}
@@ -17,6 +17,87 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					Use:       "params",
 					Short:     "Shows the parameters of the module",
 				},
+				{
+					RpcMethod: "Mints",
+					Use:       "mints",
+					Short:     "Lists the mint ledger, optionally filtered by signer or block height",
+					FlagOptions: map[string]*autocliv1.FlagOptions{
+						"signer":       {Name: "signer", Usage: "only list mints signed by this address"},
+						"block_height": {Name: "block-height", Usage: "only list mints included at this block height"},
+					},
+				},
+				{
+					RpcMethod:      "Mint",
+					Use:            "mint [id]",
+					Short:          "Shows a mint ledger entry by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "DistributionStatus",
+					Use:       "distribution-status",
+					Short:     "Shows the distribution schedule state and remaining mintable amount at the current block time",
+				},
+				{
+					RpcMethod:      "ProjectSchedule",
+					Use:            "project-schedule [start-date] [end-date]",
+					Short:          "Projects the cumulative distributable cap between two dates (YYYY-MM-DD)",
+					Example:        "project-schedule 2025-07-22 2035-07-22 --granularity PROJECTION_GRANULARITY_PERIOD --override '{\"months_in_halving_period\":24}'",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "start_date"}, {ProtoField: "end_date"}},
+				},
+				{
+					RpcMethod: "Minters",
+					Use:       "minters",
+					Short:     "Lists the registered minters and their quotas",
+				},
+				{
+					RpcMethod:      "Minter",
+					Use:            "minter [address]",
+					Short:          "Shows a registered minter with its usage in the current halving period",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "BurnedSupply",
+					Use:       "burned-supply",
+					Short:     "Shows the cumulative amount burned through the module",
+				},
+				{
+					RpcMethod: "AddressBurns",
+					Use:       "burns",
+					Short:     "Lists the cumulative amounts burned per account",
+				},
+				{
+					RpcMethod:      "AddressBurned",
+					Use:            "burned [address]",
+					Short:          "Shows the cumulative amount burned from an account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "address"}},
+				},
+				{
+					RpcMethod: "PendingParamsUpdates",
+					Use:       "pending-params-updates",
+					Short:     "Lists the scheduled params updates that have not activated yet",
+				},
+				{
+					RpcMethod:      "PendingParamsUpdate",
+					Use:            "pending-params-update [id]",
+					Short:          "Shows a scheduled params update by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod: "PauseStatus",
+					Use:       "pause-status",
+					Short:     "Shows whether minting is paused",
+				},
+				{
+					RpcMethod: "MintProposals",
+					Use:       "mint-proposals",
+					Short:     "Lists the mint proposals waiting for approvals",
+				},
+				{
+					RpcMethod:      "MintProposal",
+					Use:            "mint-proposal [id]",
+					Short:          "Shows a pending mint proposal by id",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
 				// this line is used by ignite scaffolding # autocli/query
 			},
 		},
@@ -28,12 +109,74 @@ func (am AppModule) AutoCLIOptions() *autocliv1.ModuleOptions {
 					RpcMethod: "UpdateParams",
 					Skip:      true, // skipped because authority gated
 				},
+				{
+					RpcMethod: "UpdateParamsPartial",
+					Skip:      true, // skipped because authority gated
+				},
 				{
 					RpcMethod:      "Mint",
 					Use:            "mint [amount] [signer]",
 					Short:          "Send a mint tx",
 					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}, {ProtoField: "signer"}},
 				},
+				{
+					RpcMethod: "AddMinter",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "RemoveMinter",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "SetMinterQuota",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Burn",
+					Use:            "burn [amount]",
+					Short:          "Burn coins of the module denom held by the signer",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod: "BurnFromTreasury",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "ScheduleParamsUpdate",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod: "CancelParamsUpdate",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "Pause",
+					Use:            "pause [reason]",
+					Short:          "Pause minting as the guardian",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "reason", Optional: true}},
+				},
+				{
+					RpcMethod: "Unpause",
+					Skip:      true, // skipped because authority gated
+				},
+				{
+					RpcMethod:      "ProposeMint",
+					Use:            "propose-mint [amount]",
+					Short:          "Propose a mint that runs once enough mint approvers approve it",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "amount"}},
+				},
+				{
+					RpcMethod:      "ApproveMint",
+					Use:            "approve-mint [id]",
+					Short:          "Approve a pending mint proposal",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "id"}},
+				},
+				{
+					RpcMethod:      "MintVesting",
+					Use:            "mint-vesting [recipient] [amount]",
+					Short:          "Mint coins into a continuous, delayed or periodic vesting account",
+					PositionalArgs: []*autocliv1.PositionalArgDescriptor{{ProtoField: "recipient"}, {ProtoField: "amount"}},
+				},
 				// this line is used by ignite scaffolding # autocli/tx
 			},
 		},
@@ -33,8 +33,10 @@ type ModuleInputs struct {
 	Cdc          codec.Codec
 	AddressCodec address.Codec
 
-	AccountKeeper types.AccountKeeper
-	BankKeeper    types.BankKeeper
+	AccountKeeper      types.AccountKeeper
+	BankKeeper         types.BankKeeper
+	DistributionKeeper types.DistributionKeeper
+	Erc20Keeper        types.Erc20Keeper `optional:"true"`
 }
 
 type ModuleOutputs struct {
@@ -57,6 +59,8 @@ func ProvideModule(in ModuleInputs) ModuleOutputs {
 		authority,
 		in.BankKeeper,
 		in.AccountKeeper,
+		in.DistributionKeeper,
+		in.Erc20Keeper,
 	)
 	m := NewAppModule(in.Cdc, k, in.AccountKeeper, in.BankKeeper)
 
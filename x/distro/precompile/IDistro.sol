@@ -0,0 +1,84 @@
+// SPDX-License-Identifier: LGPL-3.0-only
+pragma solidity >=0.8.17;
+
+/// @dev The IDistro contract's address.
+address constant DISTRO_PRECOMPILE_ADDRESS = 0x0000000000000000000000000000000000000900;
+
+/// @dev The IDistro contract's instance.
+IDistro constant DISTRO_CONTRACT = IDistro(DISTRO_PRECOMPILE_ADDRESS);
+
+/// @dev Params holds the x/distro parameters that govern minting. Amounts are
+/// in base units of denom, durations are in seconds.
+struct Params {
+    /// @dev Denom is the denomination minted by the module.
+    string denom;
+    /// @dev ReceivingAddress is the bech32 address that receives the mints
+    /// when no weighted recipients are configured.
+    string receivingAddress;
+    /// @dev DistributionStartDate is the start of the first halving period,
+    /// formatted as YYYY-MM-DD.
+    string distributionStartDate;
+    /// @dev MonthsInHalvingPeriod is the length of a halving period.
+    uint64 monthsInHalvingPeriod;
+    /// @dev MaxSupply caps the amount the module can ever mint.
+    uint256 maxSupply;
+    /// @dev MaxMintAmount caps a single mint. Zero means no cap.
+    uint256 maxMintAmount;
+    /// @dev MintWindow is the rolling window MaxWindowAmount applies to.
+    int64 mintWindow;
+    /// @dev MaxWindowAmount caps the amount minted within MintWindow. Zero
+    /// means no cap.
+    uint256 maxWindowAmount;
+    /// @dev MinMintInterval is the minimum time between two mints.
+    int64 minMintInterval;
+    /// @dev MintApprovalThreshold is the number of approvals a mint proposal
+    /// needs. While it is set, direct mints are rejected.
+    uint32 mintApprovalThreshold;
+}
+
+/// @title Distro Precompiled Contract
+/// @dev The interface through which solidity contracts mint through x/distro.
+/// @custom:address 0x0000000000000000000000000000000000000900
+interface IDistro {
+    /// @dev Emitted when coins are minted. Mirrors the Cosmos EventMint.
+    /// @param signer The address that minted
+    /// @param recipient The mint destination, or the receiving address
+    /// @param id The id of the mint in the mint ledger
+    /// @param amount The number of base units minted
+    /// @param halvingPeriod The 1-based halving period at the block time
+    /// @param totalDistributable The cumulative distributable cap at the block time
+    /// @param supplyAfter The total supply of denom after the mint
+    event Mint(
+        address indexed signer,
+        address indexed recipient,
+        uint64 id,
+        uint256 amount,
+        uint64 halvingPeriod,
+        uint256 totalDistributable,
+        uint256 supplyAfter
+    );
+
+    /// @dev Emitted for every account that received part of a mint. Mirrors
+    /// the payouts of the Cosmos EventMint.
+    /// @param id The id of the mint in the mint ledger
+    /// @param recipient The account that received the coins
+    /// @param amount The number of base units received
+    event Payout(uint64 indexed id, address indexed recipient, uint256 amount);
+
+    /// @dev Mint mints amount base units of the distro denom. The caller must
+    /// be a registered minter. When denom has an enabled x/erc20 native coin
+    /// token pair, the token contract also emits a Transfer from the zero
+    /// address for every payout.
+    /// @param amount The number of base units to mint
+    /// @return id The id of the mint in the mint ledger
+    function mint(uint256 amount) external returns (uint64 id);
+
+    /// @dev GetParams returns the x/distro minting parameters.
+    /// @return params The x/distro minting parameters
+    function getParams() external view returns (Params memory params);
+
+    /// @dev Mintable returns the amount that can still be minted at the
+    /// current block time under the halving schedule and the max supply.
+    /// @return amount The distributable headroom in base units
+    function mintable() external view returns (uint256 amount);
+}
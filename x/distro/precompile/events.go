@@ -0,0 +1,206 @@
+package precompile
+
+import (
+	"fmt"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+	"github.com/cosmos/evm/precompiles/erc20"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+const (
+	// EventTypeMint defines the event type for the distro Mint transaction.
+	EventTypeMint = "Mint"
+	// EventTypePayout defines the event type for every account that received
+	// part of a mint.
+	EventTypePayout = "Payout"
+)
+
+// EmitMintEvents emits a Mint log, followed by one Payout log per payout, for
+// every Cosmos EventMint in events. Mints naming the ERC-20 contract of an
+// x/erc20 native coin token pair also get an ERC-20 Transfer log from the zero
+// address per payout, emitted by that contract.
+func (p Precompile) EmitMintEvents(ctx sdk.Context, stateDB vm.StateDB, events sdk.Events) error {
+	eventMintType := proto.MessageName(&types.EventMint{})
+	for _, event := range events {
+		if event.Type != eventMintType {
+			continue
+		}
+
+		msg, err := sdk.ParseTypedEvent(abci.Event(event))
+		if err != nil {
+			return err
+		}
+		eventMint, ok := msg.(*types.EventMint)
+		if !ok {
+			return fmt.Errorf("unexpected event %T", msg)
+		}
+
+		if err := p.emitMintEvent(ctx, stateDB, eventMint); err != nil {
+			return err
+		}
+		for _, payout := range eventMint.Payouts {
+			if err := p.emitPayoutEvent(ctx, stateDB, eventMint.Id, payout); err != nil {
+				return err
+			}
+			if eventMint.Erc20Contract == "" {
+				continue
+			}
+			if err := p.emitERC20TransferEvent(ctx, stateDB, common.HexToAddress(eventMint.Erc20Contract), payout); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// emitMintEvent emits the Mint log mirroring the given EventMint.
+func (p Precompile) emitMintEvent(ctx sdk.Context, stateDB vm.StateDB, eventMint *types.EventMint) error {
+	signer, err := p.hexAddress(eventMint.Signer)
+	if err != nil {
+		return err
+	}
+	recipient, err := p.hexAddress(eventMint.Recipient)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := p.Events[EventTypeMint]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	topics[1], err = cmn.MakeTopic(signer)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(recipient)
+	if err != nil {
+		return err
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(
+		eventMint.Id,
+		eventMint.Amount.BigInt(),
+		eventMint.HalvingPeriod,
+		eventMint.TotalDistributable.BigInt(),
+		eventMint.SupplyAfter.BigInt(),
+	)
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// emitPayoutEvent emits the Payout log for a payout of the mint with the
+// given id.
+func (p Precompile) emitPayoutEvent(ctx sdk.Context, stateDB vm.StateDB, id uint64, payout types.Payout) error {
+	recipient, err := p.hexAddress(payout.Address)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := p.Events[EventTypePayout]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	topics[1], err = cmn.MakeTopic(id)
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(recipient)
+	if err != nil {
+		return err
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(payout.Amount.BigInt())
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     p.Address(),
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// emitERC20TransferEvent emits, on behalf of the ERC-20 contract of a native
+// coin token pair, the Transfer log of a payout.
+func (p Precompile) emitERC20TransferEvent(ctx sdk.Context, stateDB vm.StateDB, contract common.Address, payout types.Payout) error {
+	recipient, err := p.hexAddress(payout.Address)
+	if err != nil {
+		return err
+	}
+
+	// Prepare the event topics
+	event := erc20.ABI.Events[erc20.EventTypeTransfer]
+	topics := make([]common.Hash, 3)
+
+	// The first topic is always the signature of the event
+	topics[0] = event.ID
+
+	topics[1], err = cmn.MakeTopic(common.Address{})
+	if err != nil {
+		return err
+	}
+	topics[2], err = cmn.MakeTopic(recipient)
+	if err != nil {
+		return err
+	}
+
+	data, err := event.Inputs.NonIndexed().Pack(payout.Amount.BigInt())
+	if err != nil {
+		return fmt.Errorf("failed to pack event data: %w", err)
+	}
+
+	stateDB.AddLog(&ethtypes.Log{
+		Address:     contract,
+		Topics:      topics,
+		Data:        data,
+		BlockNumber: uint64(ctx.BlockHeight()), //nolint:gosec // G115 // won't exceed uint64
+	})
+
+	return nil
+}
+
+// hexAddress converts a bech32 address of a Cosmos event to its hex form. An
+// empty address converts to the zero address.
+func (p Precompile) hexAddress(addr string) (common.Address, error) {
+	if addr == "" {
+		return common.Address{}, nil
+	}
+
+	bz, err := p.addressCodec.StringToBytes(addr)
+	if err != nil {
+		return common.Address{}, fmt.Errorf("failed to convert address %s: %w", addr, err)
+	}
+
+	return common.BytesToAddress(bz), nil
+}
@@ -0,0 +1,73 @@
+package precompile_test
+
+import (
+	"testing"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	"github.com/cosmos/evm/precompiles/erc20"
+	"github.com/ethereum/go-ethereum/common"
+	ethtypes "github.com/ethereum/go-ethereum/core/types"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/testutil/sample"
+	"github.com/gnodi-network/gnodi/x/distro/precompile"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// logRecorder is a vm.StateDB that only records the logs added to it.
+type logRecorder struct {
+	vm.StateDB
+	logs []*ethtypes.Log
+}
+
+func (r *logRecorder) AddLog(log *ethtypes.Log) {
+	r.logs = append(r.logs, log)
+}
+
+func TestEmitMintEvents(t *testing.T) {
+	p := precompile.NewPrecompile(nil, nil, nil, evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()))
+	signer, recipient := sample.AccAddress(), sample.AccAddress()
+	token := common.HexToAddress("0xD4949664cD82660AaE99bEdc034a0deA8A0bd517")
+
+	eventMint := &types.EventMint{
+		Signer:             signer,
+		Recipient:          recipient,
+		Amount:             math.NewInt(1_000),
+		Denom:              types.DefaultDenom,
+		HalvingPeriod:      1,
+		TotalDistributable: math.NewInt(5_000),
+		SupplyAfter:        math.NewInt(1_000),
+		Id:                 7,
+		Payouts:            []types.Payout{{Address: recipient, Amount: math.NewInt(1_000)}},
+	}
+	mintEvent, err := sdk.TypedEventToEvent(eventMint)
+	require.NoError(t, err)
+	otherEvent := sdk.NewEvent("coin_received")
+
+	ctx := sdk.Context{}.WithBlockHeight(10)
+	stateDB := &logRecorder{}
+	require.NoError(t, p.EmitMintEvents(ctx, stateDB, sdk.Events{otherEvent, mintEvent}))
+	require.Len(t, stateDB.logs, 2)
+	require.Equal(t, precompile.ABI.Events[precompile.EventTypeMint].ID, stateDB.logs[0].Topics[0])
+	require.Equal(t, common.BytesToHash(sdk.MustAccAddressFromBech32(signer)), stateDB.logs[0].Topics[1])
+	require.Equal(t, precompile.ABI.Events[precompile.EventTypePayout].ID, stateDB.logs[1].Topics[0])
+	require.Equal(t, uint64(10), stateDB.logs[1].BlockNumber)
+
+	// A mint naming a token pair contract also transfers the ERC-20 from the
+	// zero address.
+	eventMint.Erc20Contract = token.Hex()
+	mintEvent, err = sdk.TypedEventToEvent(eventMint)
+	require.NoError(t, err)
+	stateDB = &logRecorder{}
+	require.NoError(t, p.EmitMintEvents(ctx, stateDB, sdk.Events{mintEvent}))
+	require.Len(t, stateDB.logs, 3)
+	transfer := stateDB.logs[2]
+	require.Equal(t, token, transfer.Address)
+	require.Equal(t, erc20.ABI.Events[erc20.EventTypeTransfer].ID, transfer.Topics[0])
+	require.Equal(t, common.Hash{}, transfer.Topics[1])
+	require.Equal(t, common.BytesToHash(sdk.MustAccAddressFromBech32(recipient)), transfer.Topics[2])
+	require.Equal(t, common.BigToHash(eventMint.Amount.BigInt()).Bytes(), transfer.Data)
+}
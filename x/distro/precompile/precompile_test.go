@@ -0,0 +1,112 @@
+package precompile_test
+
+import (
+	"context"
+	"math/big"
+	"testing"
+
+	"cosmossdk.io/core/address"
+	errorsmod "cosmossdk.io/errors"
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkerrors "github.com/cosmos/cosmos-sdk/types/errors"
+	evmaddress "github.com/cosmos/evm/encoding/address"
+	"github.com/ethereum/go-ethereum/common"
+	"github.com/ethereum/go-ethereum/core/vm"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/precompile"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// mockMsgServer is a distro MsgServer that only lets registered minters mint
+// and records the signer of every mint.
+type mockMsgServer struct {
+	types.MsgServer
+	addressCodec address.Codec
+	minters      map[string]bool
+	signers      []string
+}
+
+func (m *mockMsgServer) Mint(ctx context.Context, msg *types.MsgMint) (*types.MsgMintResponse, error) {
+	signer, err := m.addressCodec.StringToBytes(msg.Signer)
+	if err != nil {
+		return nil, err
+	}
+	if !m.minters[string(signer)] {
+		return nil, errorsmod.Wrap(sdkerrors.ErrUnauthorized, "unauthorized sender")
+	}
+	m.signers = append(m.signers, msg.Signer)
+
+	id := uint64(len(m.signers))
+	if err := sdk.UnwrapSDKContext(ctx).EventManager().EmitTypedEvent(&types.EventMint{
+		Signer: msg.Signer,
+		Amount: msg.Amount,
+		Denom:  types.DefaultDenom,
+		Id:     id,
+	}); err != nil {
+		return nil, err
+	}
+	return &types.MsgMintResponse{Id: id}, nil
+}
+
+func TestExecuteMint(t *testing.T) {
+	addressCodec := evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix())
+	safe := common.HexToAddress("0x5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE5aFE")
+	unregistered := common.HexToAddress("0x00000000000000000000000000000000DeaDBeef")
+
+	ms := &mockMsgServer{addressCodec: addressCodec, minters: map[string]bool{string(safe.Bytes()): true}}
+	p := precompile.NewPrecompile(ms, nil, nil, addressCodec)
+	precompileAddr := common.HexToAddress(types.PrecompileAddress)
+
+	input, err := precompile.ABI.Pack(precompile.MintMethod, big.NewInt(1_000))
+	require.NoError(t, err)
+	call := func(caller common.Address) *vm.Contract {
+		contract := vm.NewContract(caller, precompileAddr, nil, 100_000, nil)
+		contract.Input = input
+		return contract
+	}
+	newCtx := func() sdk.Context {
+		return sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	}
+
+	// A registered contract mints as itself.
+	stateDB := &logRecorder{}
+	bz, err := p.Execute(newCtx(), stateDB, call(safe), false)
+	require.NoError(t, err)
+	out, err := precompile.ABI.Methods[precompile.MintMethod].Outputs.Unpack(bz)
+	require.NoError(t, err)
+	require.Equal(t, uint64(1), out[0])
+
+	require.Len(t, ms.signers, 1)
+	signer, err := addressCodec.StringToBytes(ms.signers[0])
+	require.NoError(t, err)
+	require.Equal(t, safe.Bytes(), signer)
+	require.Len(t, stateDB.logs, 1)
+	require.Equal(t, common.BytesToHash(safe.Bytes()), stateDB.logs[0].Topics[1])
+
+	// A registered account calling through an unregistered contract mints
+	// as the contract, which is rejected.
+	_, err = p.Execute(newCtx(), &logRecorder{}, call(unregistered), false)
+	require.ErrorIs(t, err, sdkerrors.ErrUnauthorized)
+	require.Len(t, ms.signers, 1)
+
+	// Minting is a transaction, so a read-only call fails before minting.
+	_, err = p.Execute(newCtx(), &logRecorder{}, call(safe), true)
+	require.ErrorIs(t, err, vm.ErrWriteProtection)
+	require.Len(t, ms.signers, 1)
+}
+
+func TestMintInvalidArgs(t *testing.T) {
+	ms := &mockMsgServer{minters: map[string]bool{}}
+	p := precompile.NewPrecompile(ms, nil, nil, evmaddress.NewEvmCodec(sdk.GetConfig().GetBech32AccountAddrPrefix()))
+	method := precompile.ABI.Methods[precompile.MintMethod]
+	contract := vm.NewContract(common.Address{}, common.HexToAddress(types.PrecompileAddress), nil, 100_000, nil)
+
+	ctx := sdk.Context{}.WithEventManager(sdk.NewEventManager())
+	_, err := p.Mint(ctx, &method, &logRecorder{}, contract, []interface{}{})
+	require.Error(t, err)
+	_, err = p.Mint(ctx, &method, &logRecorder{}, contract, []interface{}{math.NewInt(1_000)})
+	require.Error(t, err)
+	require.Empty(t, ms.signers)
+}
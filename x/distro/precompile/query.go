@@ -0,0 +1,50 @@
+package precompile
+
+import (
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+const (
+	// GetParamsMethod defines the ABI method name for the distro Params query.
+	GetParamsMethod = "getParams"
+	// MintableMethod defines the ABI method name for the distributable
+	// headroom query.
+	MintableMethod = "mintable"
+)
+
+// GetParams implements the query to get the distro minting parameters.
+func (p Precompile) GetParams(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.queryServer.Params(ctx, &types.QueryParamsRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	out := new(ParamsOutput).FromResponse(res)
+	return method.Outputs.Pack(out.Params)
+}
+
+// Mintable implements the query to get the amount that can still be minted at
+// the current block time.
+func (p Precompile) Mintable(
+	ctx sdk.Context,
+	method *abi.Method,
+	_ *vm.Contract,
+	_ []interface{},
+) ([]byte, error) {
+	res, err := p.queryServer.DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+	if err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Mintable.BigInt())
+}
@@ -0,0 +1,61 @@
+package precompile
+
+import (
+	"fmt"
+	"math/big"
+
+	"github.com/ethereum/go-ethereum/accounts/abi"
+	"github.com/ethereum/go-ethereum/core/vm"
+
+	cmn "github.com/cosmos/evm/precompiles/common"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+const (
+	// MintMethod defines the ABI method name for the distro Mint transaction.
+	MintMethod = "mint"
+)
+
+// Mint implements the mint precompile transaction. It mints on behalf of the
+// immediate caller, which must be a registered minter, exactly as a MsgMint
+// signed by that account would. The transaction origin is not considered, so
+// a registered contract such as a Safe mints as itself, and an account
+// calling through an unregistered contract is rejected.
+func (p Precompile) Mint(
+	ctx sdk.Context,
+	method *abi.Method,
+	stateDB vm.StateDB,
+	contract *vm.Contract,
+	args []interface{},
+) ([]byte, error) {
+	if len(args) != 1 {
+		return nil, fmt.Errorf(cmn.ErrInvalidNumberOfArgs, 1, len(args))
+	}
+
+	amount, ok := args[0].(*big.Int)
+	if !ok || amount == nil {
+		return nil, fmt.Errorf(cmn.ErrInvalidAmount, args[0])
+	}
+
+	signer, err := p.addressCodec.BytesToString(contract.Caller().Bytes())
+	if err != nil {
+		return nil, fmt.Errorf("failed to convert caller address: %w", err)
+	}
+
+	eventsBefore := len(ctx.EventManager().Events())
+	res, err := p.msgServer.Mint(ctx, types.NewMsgMint(math.NewIntFromBigInt(amount), signer))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := p.EmitMintEvents(ctx, stateDB, ctx.EventManager().Events()[eventsBefore:]); err != nil {
+		return nil, err
+	}
+
+	return method.Outputs.Pack(res.Id)
+}
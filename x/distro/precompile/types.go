@@ -0,0 +1,45 @@
+package precompile
+
+import (
+	"math/big"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// Params is the Solidity representation of the distro minting parameters.
+// Durations are in seconds.
+type Params struct {
+	Denom                 string   `abi:"denom"`
+	ReceivingAddress      string   `abi:"receivingAddress"`
+	DistributionStartDate string   `abi:"distributionStartDate"`
+	MonthsInHalvingPeriod uint64   `abi:"monthsInHalvingPeriod"`
+	MaxSupply             *big.Int `abi:"maxSupply"`
+	MaxMintAmount         *big.Int `abi:"maxMintAmount"`
+	MintWindow            int64    `abi:"mintWindow"`
+	MaxWindowAmount       *big.Int `abi:"maxWindowAmount"`
+	MinMintInterval       int64    `abi:"minMintInterval"`
+	MintApprovalThreshold uint32   `abi:"mintApprovalThreshold"`
+}
+
+// ParamsOutput represents the output of the params query.
+type ParamsOutput struct {
+	Params Params
+}
+
+// FromResponse populates the ParamsOutput from a QueryParamsResponse.
+func (po *ParamsOutput) FromResponse(res *types.QueryParamsResponse) *ParamsOutput {
+	params := res.Params
+	po.Params = Params{
+		Denom:                 params.Denom,
+		ReceivingAddress:      params.ReceivingAddress,
+		DistributionStartDate: params.DistributionStartDate,
+		MonthsInHalvingPeriod: params.MonthsInHalvingPeriod,
+		MaxSupply:             params.MaxSupply.BigInt(),
+		MaxMintAmount:         params.MaxMintAmount.BigInt(),
+		MintWindow:            int64(params.MintWindow.Seconds()),
+		MaxWindowAmount:       params.MaxWindowAmount.BigInt(),
+		MinMintInterval:       int64(params.MinMintInterval.Seconds()),
+		MintApprovalThreshold: params.MintApprovalThreshold,
+	}
+	return po
+}
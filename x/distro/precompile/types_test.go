@@ -0,0 +1,43 @@
+package precompile_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/precompile"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestABI(t *testing.T) {
+	for _, name := range []string{precompile.MintMethod, precompile.GetParamsMethod, precompile.MintableMethod} {
+		require.Contains(t, precompile.ABI.Methods, name)
+	}
+	for _, name := range []string{precompile.EventTypeMint, precompile.EventTypePayout} {
+		require.Contains(t, precompile.ABI.Events, name)
+	}
+}
+
+func TestParamsOutput(t *testing.T) {
+	params := types.DefaultParams()
+	params.MaxMintAmount = math.NewInt(1_000)
+	params.MintWindow = 24 * time.Hour
+	params.MaxWindowAmount = math.NewInt(5_000)
+
+	out := new(precompile.ParamsOutput).FromResponse(&types.QueryParamsResponse{Params: params})
+	require.Equal(t, params.Denom, out.Params.Denom)
+	require.Equal(t, params.MaxSupply.BigInt(), out.Params.MaxSupply)
+	require.Equal(t, int64(86_400), out.Params.MintWindow)
+
+	method := precompile.ABI.Methods[precompile.GetParamsMethod]
+	bz, err := method.Outputs.Pack(out.Params)
+	require.NoError(t, err)
+
+	values, err := method.Outputs.Unpack(bz)
+	require.NoError(t, err)
+	var unpacked precompile.ParamsOutput
+	require.NoError(t, method.Outputs.Copy(&unpacked, values))
+	require.Equal(t, out.Params, unpacked.Params)
+}
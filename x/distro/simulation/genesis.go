@@ -0,0 +1,71 @@
+package simulation
+
+import (
+	"math/rand"
+	"time"
+
+	"github.com/cosmos/cosmos-sdk/types/module"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// Simulation parameter constants
+const (
+	Minter                = "minter"
+	ReceivingAddress      = "receiving_address"
+	DistributionStartDate = "distribution_start_date"
+	MonthsInHalvingPeriod = "months_in_halving_period"
+)
+
+// GenMinter randomized Minter
+func GenMinter(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+// GenReceivingAddress randomized ReceivingAddress
+func GenReceivingAddress(r *rand.Rand, accs []simtypes.Account) string {
+	acc, _ := simtypes.RandomAcc(r, accs)
+	return acc.Address.String()
+}
+
+// GenDistributionStartDate randomized DistributionStartDate. The date lies
+// within the four years before genesis, so the schedule has already unlocked
+// some supply when the simulation starts.
+func GenDistributionStartDate(r *rand.Rand, genTime time.Time) string {
+	return genTime.AddDate(0, 0, -r.Intn(4*365)).Format("2006-01-02")
+}
+
+// GenMonthsInHalvingPeriod randomized MonthsInHalvingPeriod
+func GenMonthsInHalvingPeriod(r *rand.Rand) uint64 {
+	return uint64(simtypes.RandIntBetween(r, 1, 49))
+}
+
+// RandomizedGenState generates a random GenesisState for distro
+func RandomizedGenState(simState *module.SimulationState) {
+	var minter string
+	simState.AppParams.GetOrGenerate(Minter, &minter, simState.Rand, func(r *rand.Rand) { minter = GenMinter(r, simState.Accounts) })
+
+	var receivingAddress string
+	simState.AppParams.GetOrGenerate(ReceivingAddress, &receivingAddress, simState.Rand, func(r *rand.Rand) {
+		receivingAddress = GenReceivingAddress(r, simState.Accounts)
+	})
+
+	var distributionStartDate string
+	simState.AppParams.GetOrGenerate(DistributionStartDate, &distributionStartDate, simState.Rand, func(r *rand.Rand) {
+		distributionStartDate = GenDistributionStartDate(r, simState.GenTimestamp)
+	})
+
+	var monthsInHalvingPeriod uint64
+	simState.AppParams.GetOrGenerate(MonthsInHalvingPeriod, &monthsInHalvingPeriod, simState.Rand, func(r *rand.Rand) {
+		monthsInHalvingPeriod = GenMonthsInHalvingPeriod(r)
+	})
+
+	params := types.NewParams(receivingAddress, types.DefaultDenom, types.DefaultMaxSupply, distributionStartDate, monthsInHalvingPeriod)
+
+	distroGenesis := types.DefaultGenesis()
+	distroGenesis.Params = params
+	distroGenesis.Minters = []types.Minter{types.NewMinter(minter, types.UnlimitedMinterQuota())}
+	simState.GenState[types.ModuleName] = simState.Cdc.MustMarshalJSON(distroGenesis)
+}
@@ -0,0 +1,58 @@
+package simulation_test
+
+import (
+	"encoding/json"
+	"math/rand"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/module"
+	moduletestutil "github.com/cosmos/cosmos-sdk/types/module/testutil"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/stretchr/testify/require"
+
+	distro "github.com/gnodi-network/gnodi/x/distro/module"
+	"github.com/gnodi-network/gnodi/x/distro/simulation"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestRandomizedGenState(t *testing.T) {
+	encCfg := moduletestutil.MakeTestEncodingConfig(distro.AppModule{})
+	r := rand.New(rand.NewSource(1))
+	genTime := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+
+	simState := module.SimulationState{
+		AppParams:    make(simtypes.AppParams),
+		Cdc:          encCfg.Codec,
+		Rand:         r,
+		NumBonded:    3,
+		BondDenom:    sdk.DefaultBondDenom,
+		Accounts:     simtypes.RandomAccounts(r, 3),
+		InitialStake: math.NewInt(1000),
+		GenState:     make(map[string]json.RawMessage),
+		GenTimestamp: genTime,
+	}
+
+	simulation.RandomizedGenState(&simState)
+
+	var distroGenesis types.GenesisState
+	simState.Cdc.MustUnmarshalJSON(simState.GenState[types.ModuleName], &distroGenesis)
+
+	require.NoError(t, distroGenesis.Validate())
+	require.NoError(t, distroGenesis.Params.Validate())
+	require.Len(t, distroGenesis.Minters, 1)
+
+	accs := make([]string, len(simState.Accounts))
+	for i, acc := range simState.Accounts {
+		accs[i] = acc.Address.String()
+	}
+	require.Contains(t, accs, distroGenesis.Minters[0].Address)
+	require.Contains(t, accs, distroGenesis.Params.ReceivingAddress)
+
+	startDate, err := types.ParseScheduleTime(distroGenesis.Params.DistributionStartDate)
+	require.NoError(t, err)
+	require.False(t, startDate.After(genTime))
+	require.NotZero(t, distroGenesis.Params.MonthsInHalvingPeriod)
+}
@@ -1,17 +1,26 @@
 package simulation
 
 import (
+	"fmt"
 	"math/rand"
 
+	"cosmossdk.io/math"
+
 	"github.com/cosmos/cosmos-sdk/baseapp"
 	"github.com/cosmos/cosmos-sdk/client"
+	simtestutil "github.com/cosmos/cosmos-sdk/testutil/sims"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
 
 	"github.com/gnodi-network/gnodi/x/distro/keeper"
 	"github.com/gnodi-network/gnodi/x/distro/types"
 )
 
+// SimulateMsgMint generates a MsgMint signed by a random registered minter.
+// Most mints stay within the headroom left by the distribution schedule and
+// the max supply and are expected to succeed. Now and then the amount
+// deliberately exceeds the headroom, and the mint is expected to be rejected.
 func SimulateMsgMint(
 	ak types.AccountKeeper,
 	bk types.BankKeeper,
@@ -20,14 +29,122 @@ func SimulateMsgMint(
 ) simtypes.Operation {
 	return func(r *rand.Rand, app *baseapp.BaseApp, ctx sdk.Context, accs []simtypes.Account, chainID string,
 	) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
-		simAccount, _ := simtypes.RandomAcc(r, accs)
-		msg := &types.MsgMint{
-			Signer: simAccount.Address.String(),
-			Amount: uint64(r.Intn(100000000)),
+		msgType := sdk.MsgTypeURL(&types.MsgMint{})
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get params"), nil, err
+		}
+		if params.MintApprovalThreshold > 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mints require approval"), nil, nil
+		}
+		if params.MinMintInterval > 0 || params.MintWindow > 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "mint window rate limits are set"), nil, nil
+		}
+		paused, err := k.IsPaused(ctx)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get pause status"), nil, err
+		}
+		if paused {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "minting is paused"), nil, nil
 		}
 
-		// TODO: Handle the Mint simulation
+		var minters []simtypes.Account
+		err = k.Minters.Walk(ctx, nil, func(addr sdk.AccAddress, minter types.Minter) (bool, error) {
+			if acc, found := simtypes.FindAccount(accs, addr); found && !minter.Quota.HasShare() && !minter.Quota.HasPeriodLimit() {
+				minters = append(minters, acc)
+			}
+			return false, nil
+		})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get minters"), nil, err
+		}
+		if len(minters) == 0 {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no unlimited minter among the accounts"), nil, nil
+		}
+		simAccount := minters[r.Intn(len(minters))]
 
-		return simtypes.NoOpMsg(types.ModuleName, sdk.MsgTypeURL(msg), "Mint simulation not implemented"), nil, nil
+		status, err := keeper.NewQueryServerImpl(k).DistributionStatus(ctx, &types.QueryDistributionStatusRequest{})
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to get distribution status"), nil, err
+		}
+		headroom := status.Mintable
+		if maxAmount := params.MaxMintAmount; !maxAmount.IsNil() && maxAmount.IsPositive() && maxAmount.LT(headroom) {
+			headroom = maxAmount
+		}
+
+		// Exceed the headroom in one operation out of ten.
+		if r.Intn(10) == 0 {
+			msg := types.NewMsgMint(headroom.Add(math.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000)))), simAccount.Address.String())
+			return deliverRejectedMint(r, app, ctx, ak, bk, txGen, chainID, simAccount, msg)
+		}
+
+		if !headroom.IsPositive() {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "no headroom left"), nil, nil
+		}
+		amount, err := simtypes.RandPositiveInt(r, headroom)
+		if err != nil {
+			return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate amount"), nil, err
+		}
+		msg := types.NewMsgMint(amount, simAccount.Address.String())
+
+		txCtx := simulation.OperationInput{
+			R:               r,
+			App:             app,
+			TxGen:           txGen,
+			Cdc:             nil,
+			Msg:             msg,
+			Context:         ctx,
+			SimAccount:      simAccount,
+			AccountKeeper:   ak,
+			Bankkeeper:      bk,
+			ModuleName:      types.ModuleName,
+			CoinsSpentInMsg: sdk.NewCoins(),
+		}
+		return simulation.GenAndDeliverTxWithRandFees(txCtx)
+	}
+}
+
+// deliverRejectedMint delivers msg, a mint above the headroom, and fails the
+// simulation if it goes through.
+func deliverRejectedMint(
+	r *rand.Rand,
+	app *baseapp.BaseApp,
+	ctx sdk.Context,
+	ak types.AccountKeeper,
+	bk types.BankKeeper,
+	txGen client.TxConfig,
+	chainID string,
+	simAccount simtypes.Account,
+	msg *types.MsgMint,
+) (simtypes.OperationMsg, []simtypes.FutureOperation, error) {
+	msgType := sdk.MsgTypeURL(msg)
+
+	account := ak.GetAccount(ctx, simAccount.Address)
+	fees, err := simtypes.RandomFees(r, ctx, bk.SpendableCoins(ctx, simAccount.Address))
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate fees"), nil, err
+	}
+
+	tx, err := simtestutil.GenSignedMockTx(
+		r,
+		txGen,
+		[]sdk.Msg{msg},
+		fees,
+		simtestutil.DefaultGenTxGas,
+		chainID,
+		[]uint64{account.GetAccountNumber()},
+		[]uint64{account.GetSequence()},
+		simAccount.PrivKey,
+	)
+	if err != nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "unable to generate mock tx"), nil, err
 	}
+
+	if _, _, err := app.SimDeliver(txGen.TxEncoder(), tx); err == nil {
+		return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the headroom"), nil,
+			fmt.Errorf("mint of %s above the headroom was accepted", msg.Amount)
+	}
+
+	return simtypes.NoOpMsg(types.ModuleName, msgType, "mint above the headroom rejected"), nil, nil
 }
@@ -0,0 +1,59 @@
+package simulation
+
+import (
+	"math/rand"
+
+	"cosmossdk.io/math"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/cosmos-sdk/types/address"
+	simtypes "github.com/cosmos/cosmos-sdk/types/simulation"
+	"github.com/cosmos/cosmos-sdk/x/simulation"
+
+	"github.com/gnodi-network/gnodi/x/distro/keeper"
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+// Simulation operation weights constants
+const (
+	DefaultWeightMsgUpdateParams int = 100
+
+	OpWeightMsgUpdateParams = "op_weight_msg_update_params"
+)
+
+// ProposalMsgs defines the module weighted proposals' contents
+func ProposalMsgs(k keeper.Keeper) []simtypes.WeightedProposalMsg {
+	return []simtypes.WeightedProposalMsg{
+		simulation.NewWeightedProposalMsg(
+			OpWeightMsgUpdateParams,
+			DefaultWeightMsgUpdateParams,
+			SimulateMsgUpdateParams(k),
+		),
+	}
+}
+
+// SimulateMsgUpdateParams returns a MsgUpdateParams that changes the
+// receiving address and the per-mint cap of the current params. The
+// distribution schedule is left untouched so that the update passes the
+// schedule guard.
+func SimulateMsgUpdateParams(k keeper.Keeper) simtypes.MsgSimulatorFn {
+	return func(r *rand.Rand, ctx sdk.Context, accs []simtypes.Account) sdk.Msg {
+		// use the default gov module account address as authority
+		var authority sdk.AccAddress = address.Module("gov")
+
+		params, err := k.Params.Get(ctx)
+		if err != nil {
+			params = types.DefaultParams()
+		}
+		params.ReceivingAddress = GenReceivingAddress(r, accs)
+		params.MaxMintAmount = math.ZeroInt()
+		if r.Intn(2) == 0 {
+			params.MaxMintAmount = math.NewInt(int64(simtypes.RandIntBetween(r, 1, 1_000_000_000_000)))
+		}
+
+		return &types.MsgUpdateParams{
+			Authority: authority.String(),
+			Params:    params,
+		}
+	}
+}
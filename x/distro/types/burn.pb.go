@@ -0,0 +1,375 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gnodi/distro/v1/burn.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// AddressBurned is the cumulative amount burned from a single account.
+type AddressBurned struct {
+	// address is the account the coins were burned from.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// amount is the cumulative number of base units burned.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *AddressBurned) Reset()         { *m = AddressBurned{} }
+func (m *AddressBurned) String() string { return proto.CompactTextString(m) }
+func (*AddressBurned) ProtoMessage()    {}
+func (*AddressBurned) Descriptor() ([]byte, []int) {
+	return fileDescriptor_83eeff440842355e, []int{0}
+}
+func (m *AddressBurned) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *AddressBurned) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_AddressBurned.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *AddressBurned) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddressBurned.Merge(m, src)
+}
+func (m *AddressBurned) XXX_Size() int {
+	return m.Size()
+}
+func (m *AddressBurned) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddressBurned.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddressBurned proto.InternalMessageInfo
+
+func (m *AddressBurned) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*AddressBurned)(nil), "gnodi.distro.v1.AddressBurned")
+}
+
+func init() { proto.RegisterFile("gnodi/distro/v1/burn.proto", fileDescriptor_83eeff440842355e) }
+
+var fileDescriptor_83eeff440842355e = []byte{
+	// 271 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x50, 0xbf, 0x4a, 0xc3, 0x40,
+	0x18, 0xcf, 0x39, 0x54, 0x0c, 0x88, 0x18, 0x2a, 0xc4, 0x0c, 0x57, 0x71, 0x12, 0x21, 0x77, 0x56,
+	0x9f, 0xc0, 0x2c, 0xda, 0xb5, 0x6e, 0x2e, 0x92, 0xf4, 0x42, 0x7a, 0x94, 0xdc, 0x57, 0xee, 0xbe,
+	0x54, 0x7d, 0x08, 0xc1, 0xc7, 0x70, 0x74, 0xe8, 0x43, 0x74, 0x2c, 0x9d, 0xc4, 0xa1, 0x48, 0x32,
+	0xf8, 0x1a, 0xe2, 0xdd, 0x75, 0x39, 0xee, 0xf7, 0x17, 0xbe, 0x5f, 0x98, 0x54, 0x0a, 0x84, 0xe4,
+	0x42, 0x1a, 0xd4, 0xc0, 0x17, 0x43, 0x5e, 0x34, 0x5a, 0xb1, 0xb9, 0x06, 0x84, 0xe8, 0xc8, 0x6a,
+	0xcc, 0x69, 0x6c, 0x31, 0x4c, 0x8e, 0xf3, 0x5a, 0x2a, 0xe0, 0xf6, 0x75, 0x9e, 0xe4, 0x74, 0x02,
+	0xa6, 0x06, 0xf3, 0x64, 0x11, 0x77, 0xc0, 0x4b, 0xfd, 0x0a, 0x2a, 0x70, 0xfc, 0xff, 0xcf, 0xb1,
+	0xe7, 0x6f, 0x24, 0x3c, 0xbc, 0x15, 0x42, 0x97, 0xc6, 0x64, 0x8d, 0x56, 0xa5, 0x88, 0xae, 0xc3,
+	0xfd, 0xdc, 0x11, 0x31, 0x39, 0x23, 0x17, 0x07, 0x59, 0xbc, 0x59, 0xa6, 0x7d, 0x5f, 0xe5, 0xad,
+	0x0f, 0xa8, 0xa5, 0xaa, 0xc6, 0x3b, 0x63, 0x74, 0x1f, 0xf6, 0xf2, 0x1a, 0x1a, 0x85, 0xf1, 0x9e,
+	0x8d, 0x5c, 0xad, 0xb6, 0x83, 0xe0, 0x7b, 0x3b, 0x38, 0x71, 0x31, 0x23, 0x66, 0x4c, 0x02, 0xaf,
+	0x73, 0x9c, 0xb2, 0x91, 0xc2, 0xcd, 0x32, 0x0d, 0x7d, 0xdf, 0x48, 0xe1, 0xc7, 0xef, 0xe7, 0x25,
+	0x19, 0xfb, 0x7c, 0x76, 0xb7, 0x6a, 0x29, 0x59, 0xb7, 0x94, 0xfc, 0xb4, 0x94, 0xbc, 0x77, 0x34,
+	0x58, 0x77, 0x34, 0xf8, 0xea, 0x68, 0xf0, 0x98, 0x56, 0x12, 0xa7, 0x4d, 0xc1, 0x26, 0x50, 0x73,
+	0xbb, 0x44, 0xaa, 0x4a, 0x7c, 0x06, 0x3d, 0x73, 0x88, 0xbf, 0xec, 0x56, 0xc3, 0xd7, 0x79, 0x69,
+	0x8a, 0x9e, 0xbd, 0xef, 0xe6, 0x6f, 0x00, 0x2c, 0x6f, 0xec, 0x0e, 0x52, 0x01, 0x00, 0x00,
+}
+
+func (m *AddressBurned) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *AddressBurned) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *AddressBurned) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintBurn(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintBurn(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintBurn(dAtA []byte, offset int, v uint64) int {
+	offset -= sovBurn(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *AddressBurned) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovBurn(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovBurn(uint64(l))
+	return n
+}
+
+func sovBurn(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozBurn(x uint64) (n int) {
+	return sovBurn(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *AddressBurned) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: AddressBurned: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: AddressBurned: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthBurn
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipBurn(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthBurn
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipBurn(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowBurn
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowBurn
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthBurn
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupBurn
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthBurn
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthBurn        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowBurn          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupBurn = fmt.Errorf("proto: unexpected end of group")
+)
@@ -9,10 +9,23 @@ import (
 func RegisterInterfaces(registrar codectypes.InterfaceRegistry) {
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgMint{},
+		&MsgBurn{},
+		&MsgPause{},
+		&MsgProposeMint{},
+		&MsgApproveMint{},
+		&MsgMintVesting{},
 	)
 
 	registrar.RegisterImplementations((*sdk.Msg)(nil),
 		&MsgUpdateParams{},
+		&MsgUpdateParamsPartial{},
+		&MsgScheduleParamsUpdate{},
+		&MsgCancelParamsUpdate{},
+		&MsgUnpause{},
+		&MsgAddMinter{},
+		&MsgRemoveMinter{},
+		&MsgSetMinterQuota{},
+		&MsgBurnFromTreasury{},
 	)
 	msgservice.RegisterMsgServiceDesc(registrar, &_Msg_serviceDesc)
 }
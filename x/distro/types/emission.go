@@ -0,0 +1,115 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+)
+
+// DefaultEmissionCurve returns the halving curve.
+func DefaultEmissionCurve() EmissionCurve {
+	return EmissionCurve{
+		Type:       EmissionCurveType_EMISSION_CURVE_TYPE_HALVING,
+		DecayRatio: math.LegacyZeroDec(),
+	}
+}
+
+// NewLinearEmissionCurve returns a curve that unlocks the max supply linearly
+// over the given number of months.
+func NewLinearEmissionCurve(durationMonths uint64) EmissionCurve {
+	curve := DefaultEmissionCurve()
+	curve.Type = EmissionCurveType_EMISSION_CURVE_TYPE_LINEAR
+	curve.DurationMonths = durationMonths
+	return curve
+}
+
+// NewExponentialEmissionCurve returns a curve whose period amounts decay by
+// the given ratio.
+func NewExponentialEmissionCurve(decayRatio math.LegacyDec) EmissionCurve {
+	curve := DefaultEmissionCurve()
+	curve.Type = EmissionCurveType_EMISSION_CURVE_TYPE_EXPONENTIAL
+	curve.DecayRatio = decayRatio
+	return curve
+}
+
+// NewPiecewiseEmissionCurve returns a curve interpolated between the given
+// points.
+func NewPiecewiseEmissionCurve(points ...EmissionPoint) EmissionCurve {
+	curve := DefaultEmissionCurve()
+	curve.Type = EmissionCurveType_EMISSION_CURVE_TYPE_PIECEWISE
+	curve.Points = points
+	return curve
+}
+
+// NewEmissionPoint creates a new EmissionPoint.
+func NewEmissionPoint(date string, cumulativeCap math.Int) EmissionPoint {
+	return EmissionPoint{
+		Date:          date,
+		CumulativeCap: cumulativeCap,
+	}
+}
+
+// Validate validates the emission curve of params with the given max supply
+// and distribution start date.
+func (c EmissionCurve) Validate(maxSupply math.Int, distributionStartDate string) error {
+	hasRatio := !c.DecayRatio.IsNil() && !c.DecayRatio.IsZero()
+
+	switch c.Type {
+	case EmissionCurveType_EMISSION_CURVE_TYPE_HALVING:
+		if c.DurationMonths != 0 || hasRatio || len(c.Points) != 0 {
+			return fmt.Errorf("halving emission curve takes no duration, decay ratio or points")
+		}
+	case EmissionCurveType_EMISSION_CURVE_TYPE_LINEAR:
+		if hasRatio || len(c.Points) != 0 {
+			return fmt.Errorf("linear emission curve takes no decay ratio or points")
+		}
+		if c.DurationMonths == 0 {
+			return fmt.Errorf("linear emission curve duration must be greater than zero")
+		}
+	case EmissionCurveType_EMISSION_CURVE_TYPE_EXPONENTIAL:
+		if c.DurationMonths != 0 || len(c.Points) != 0 {
+			return fmt.Errorf("exponential emission curve takes no duration or points")
+		}
+		if !hasRatio || c.DecayRatio.IsNegative() || c.DecayRatio.GTE(math.LegacyOneDec()) {
+			return fmt.Errorf("exponential emission curve decay ratio must be greater than 0 and lower than 1, got %s", c.DecayRatio)
+		}
+	case EmissionCurveType_EMISSION_CURVE_TYPE_PIECEWISE:
+		if c.DurationMonths != 0 || hasRatio {
+			return fmt.Errorf("piecewise emission curve takes no duration or decay ratio")
+		}
+		return validateEmissionPoints(c.Points, maxSupply, distributionStartDate)
+	default:
+		return fmt.Errorf("invalid emission curve type %d", c.Type)
+	}
+	return nil
+}
+
+func validateEmissionPoints(points []EmissionPoint, maxSupply math.Int, distributionStartDate string) error {
+	if len(points) == 0 {
+		return fmt.Errorf("piecewise emission curve needs at least one point")
+	}
+
+	prevDate, err := ParseScheduleTime(distributionStartDate)
+	if err != nil {
+		return fmt.Errorf("distribution start date must be in YYYY-MM-DD or RFC3339 format: %w", err)
+	}
+	prevCap := math.ZeroInt()
+	for _, point := range points {
+		date, err := ParseScheduleTime(point.Date)
+		if err != nil {
+			return fmt.Errorf("emission point date must be in YYYY-MM-DD or RFC3339 format: %w", err)
+		}
+		if !date.After(prevDate) {
+			return fmt.Errorf("emission point dates must be after the distribution start date and increasing, got %s", point.Date)
+		}
+		if point.CumulativeCap.IsNil() || point.CumulativeCap.LT(prevCap) {
+			return fmt.Errorf("emission point cumulative caps cannot decrease, got %s on %s", point.CumulativeCap, point.Date)
+		}
+		prevDate, prevCap = date, point.CumulativeCap
+	}
+
+	if !maxSupply.IsNil() && prevCap.GT(maxSupply) {
+		return fmt.Errorf("emission point cumulative cap %s exceeds max supply %s", prevCap, maxSupply)
+	}
+	return nil
+}
@@ -8,5 +8,18 @@ import (
 
 // x/distro module sentinel errors
 var (
-	ErrInvalidSigner = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrInvalidSigner        = errors.Register(ModuleName, 1100, "expected gov account as only signer for proposal message")
+	ErrMinterNotFound       = errors.Register(ModuleName, 1101, "minter not found")
+	ErrMinterAlreadyExists  = errors.Register(ModuleName, 1102, "minter already exists")
+	ErrMinterQuotaExceeded  = errors.Register(ModuleName, 1103, "minter quota exceeded")
+	ErrParamsUpdateNotFound = errors.Register(ModuleName, 1104, "scheduled params update not found")
+	ErrScheduleGuard        = errors.Register(ModuleName, 1105, "params change rewrites the distribution schedule")
+	ErrMintAmountTooLarge   = errors.Register(ModuleName, 1106, "mint amount exceeds the per-mint limit")
+	ErrMintWindowExceeded   = errors.Register(ModuleName, 1107, "mint window limit exceeded")
+	ErrMintTooFrequent      = errors.Register(ModuleName, 1108, "mint interval too short")
+	ErrPaused               = errors.Register(ModuleName, 1109, "minting is paused")
+	ErrMintProposalNotFound = errors.Register(ModuleName, 1110, "mint proposal not found")
+	ErrMintApprovalRequired = errors.Register(ModuleName, 1111, "mint requires approval")
+	ErrMintProposalExpired  = errors.Register(ModuleName, 1112, "mint proposal expired")
+	ErrRecipientNotAllowed  = errors.Register(ModuleName, 1113, "recipient is not an allowed mint destination")
 )
@@ -7,9 +7,9 @@ import (
 )
 
 // DefaultGenesis returns the default genesis state.
-// MintingAddress and ReceivingAddress are empty by default because they are
-// deployment-specific values that must be set by the chain operator before
-// minting operations can proceed. Use Params.Validate() to enforce their
+// ReceivingAddress is empty and no minter is registered by default because
+// they are deployment-specific values that must be set by the chain operator
+// before minting operations can proceed. Use Params.Validate() to enforce their
 // presence at runtime (e.g. in MsgUpdateParams).
 func DefaultGenesis() *GenesisState {
 	return &GenesisState{
@@ -18,15 +18,16 @@ func DefaultGenesis() *GenesisState {
 }
 
 // Validate performs genesis state validation.
-// Addresses are allowed to be empty in genesis — they are deployment-specific
-// and must be configured before minting. If provided, they must be valid bech32.
-// Use Params.Validate() for strict runtime enforcement.
+// The receiving address is allowed to be empty in genesis — it is
+// deployment-specific and must be configured before minting. If provided, it
+// must be valid bech32. Use Params.Validate() for strict runtime enforcement.
 func (gs GenesisState) Validate() error {
 	p := gs.Params
-	if p.MintingAddress != "" {
-		if _, err := sdk.AccAddressFromBech32(p.MintingAddress); err != nil {
-			return fmt.Errorf("invalid minting address: %w", err)
-		}
+	if err := validateMintingAddress(p.MintingAddress); err != nil { //nolint:staticcheck // only checked to be unset
+		return err
+	}
+	if err := validateLegacyMaxSupply(p.LegacyMaxSupply); err != nil { //nolint:staticcheck // only checked to be unset
+		return err
 	}
 	if p.ReceivingAddress != "" {
 		if _, err := sdk.AccAddressFromBech32(p.ReceivingAddress); err != nil {
@@ -42,5 +43,147 @@ func (gs GenesisState) Validate() error {
 	if err := validateMonthsInHalvingPeriod(p.MonthsInHalvingPeriod); err != nil {
 		return err
 	}
+	if err := validateRecipients(p.Recipients); err != nil {
+		return err
+	}
+	if err := validateAutoMint(p.AutoMint, p.AutoMintEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateSupplyBasis(p.MaxSupplyBasis); err != nil {
+		return err
+	}
+	if err := validateMaxUnlockJump(p.MaxUnlockJump); err != nil {
+		return err
+	}
+	if err := validateMaxSupply(p.MaxSupply); err != nil {
+		return err
+	}
+	if err := p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate); err != nil {
+		return err
+	}
+	if err := validateSchedulePrecision(p.SchedulePrecision); err != nil {
+		return err
+	}
+	if err := validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval); err != nil {
+		return err
+	}
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+	if err := validateMintApprovals(p.MintApprovers, p.MintApprovalThreshold, p.MintProposalTtl); err != nil {
+		return err
+	}
+	if err := validateMintDestinations(p.MintDestinations); err != nil {
+		return err
+	}
+	if gs.PauseStatus.Paused {
+		if _, err := sdk.AccAddressFromBech32(gs.PauseStatus.PausedBy); err != nil {
+			return fmt.Errorf("invalid pause status signer: %w", err)
+		}
+	}
+	if !gs.MintedSupply.IsNil() && gs.MintedSupply.IsNegative() {
+		return fmt.Errorf("minted supply cannot be negative: %s", gs.MintedSupply)
+	}
+	if !gs.BurnedSupply.IsNil() && gs.BurnedSupply.IsNegative() {
+		return fmt.Errorf("burned supply cannot be negative: %s", gs.BurnedSupply)
+	}
+
+	seen := make(map[uint64]struct{}, len(gs.Mints))
+	for _, record := range gs.Mints {
+		if _, ok := seen[record.Id]; ok {
+			return fmt.Errorf("duplicate mint record id %d", record.Id)
+		}
+		seen[record.Id] = struct{}{}
+		if record.Id >= gs.MintSequence {
+			return fmt.Errorf("mint record id %d must be lower than mint sequence %d", record.Id, gs.MintSequence)
+		}
+	}
+
+	minters := make(map[string]struct{}, len(gs.Minters))
+	for _, minter := range gs.Minters {
+		if err := minter.Validate(); err != nil {
+			return err
+		}
+		if _, ok := minters[minter.Address]; ok {
+			return fmt.Errorf("duplicate minter %s", minter.Address)
+		}
+		minters[minter.Address] = struct{}{}
+	}
+
+	usages := make(map[string]struct{}, len(gs.MinterUsages))
+	for _, usage := range gs.MinterUsages {
+		if _, err := sdk.AccAddressFromBech32(usage.Address); err != nil {
+			return fmt.Errorf("invalid minter usage address: %w", err)
+		}
+		key := fmt.Sprintf("%s/%d", usage.Address, usage.HalvingPeriod)
+		if _, ok := usages[key]; ok {
+			return fmt.Errorf("duplicate minter usage for %s in period %d", usage.Address, usage.HalvingPeriod)
+		}
+		usages[key] = struct{}{}
+	}
+
+	burns := make(map[string]struct{}, len(gs.AddressBurns))
+	for _, burned := range gs.AddressBurns {
+		if _, err := sdk.AccAddressFromBech32(burned.Address); err != nil {
+			return fmt.Errorf("invalid burn address: %w", err)
+		}
+		if burned.Amount.IsNil() || burned.Amount.IsNegative() {
+			return fmt.Errorf("burned amount of %s cannot be negative", burned.Address)
+		}
+		if _, ok := burns[burned.Address]; ok {
+			return fmt.Errorf("duplicate burned amount for %s", burned.Address)
+		}
+		burns[burned.Address] = struct{}{}
+	}
+
+	recentMints := make(map[uint64]struct{}, len(gs.RecentMints))
+	for _, recent := range gs.RecentMints {
+		if _, ok := recentMints[recent.Id]; ok {
+			return fmt.Errorf("duplicate recent mint id %d", recent.Id)
+		}
+		recentMints[recent.Id] = struct{}{}
+		if recent.Amount.IsNil() || !recent.Amount.IsPositive() {
+			return fmt.Errorf("recent mint %d amount must be positive", recent.Id)
+		}
+	}
+
+	updates := make(map[uint64]struct{}, len(gs.PendingParamsUpdates))
+	for _, update := range gs.PendingParamsUpdates {
+		if _, ok := updates[update.Id]; ok {
+			return fmt.Errorf("duplicate scheduled params update id %d", update.Id)
+		}
+		updates[update.Id] = struct{}{}
+		if update.Id >= gs.ParamsUpdateSequence {
+			return fmt.Errorf("scheduled params update id %d must be lower than params update sequence %d", update.Id, gs.ParamsUpdateSequence)
+		}
+		if err := update.ValidateActivation(); err != nil {
+			return fmt.Errorf("scheduled params update %d: %w", update.Id, err)
+		}
+	}
+
+	proposals := make(map[uint64]struct{}, len(gs.MintProposals))
+	for _, proposal := range gs.MintProposals {
+		if _, ok := proposals[proposal.Id]; ok {
+			return fmt.Errorf("duplicate mint proposal id %d", proposal.Id)
+		}
+		proposals[proposal.Id] = struct{}{}
+		if proposal.Id >= gs.MintProposalSequence {
+			return fmt.Errorf("mint proposal id %d must be lower than mint proposal sequence %d", proposal.Id, gs.MintProposalSequence)
+		}
+		if _, err := sdk.AccAddressFromBech32(proposal.Proposer); err != nil {
+			return fmt.Errorf("invalid mint proposal proposer: %w", err)
+		}
+		if proposal.Amount.IsNil() || !proposal.Amount.IsPositive() {
+			return fmt.Errorf("mint proposal %d amount must be positive", proposal.Id)
+		}
+		if proposal.Recipient != "" {
+			if _, err := sdk.AccAddressFromBech32(proposal.Recipient); err != nil {
+				return fmt.Errorf("invalid mint proposal recipient: %w", err)
+			}
+		}
+		if len(proposal.Reference) > MaxMintReferenceLength {
+			return fmt.Errorf("mint proposal %d reference cannot be longer than %d bytes", proposal.Id, MaxMintReferenceLength)
+		}
+	}
 	return nil
 }
@@ -4,7 +4,9 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
@@ -28,6 +30,36 @@ const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 type GenesisState struct {
 	// params defines all the parameters of the module.
 	Params Params `protobuf:"bytes,1,opt,name=params,proto3" json:"params"`
+	// mints holds the mint ledger.
+	Mints []MintRecord `protobuf:"bytes,2,rep,name=mints,proto3" json:"mints"`
+	// mint_sequence is the id that will be assigned to the next mint.
+	MintSequence uint64 `protobuf:"varint,3,opt,name=mint_sequence,json=mintSequence,proto3" json:"mint_sequence,omitempty"`
+	// minters holds the minter registry.
+	Minters []Minter `protobuf:"bytes,4,rep,name=minters,proto3" json:"minters"`
+	// minter_usages holds the amounts minted per minter and halving period.
+	MinterUsages []MinterUsage `protobuf:"bytes,5,rep,name=minter_usages,json=minterUsages,proto3" json:"minter_usages"`
+	// auto_mint_watermark records how far automatic minting has progressed.
+	AutoMintWatermark AutoMintWatermark `protobuf:"bytes,6,opt,name=auto_mint_watermark,json=autoMintWatermark,proto3" json:"auto_mint_watermark"`
+	// minted_supply is the cumulative amount minted by the module.
+	MintedSupply cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=minted_supply,json=mintedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"minted_supply"`
+	// burned_supply is the cumulative amount burned through the module.
+	BurnedSupply cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=burned_supply,json=burnedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"burned_supply"`
+	// address_burns holds the cumulative amount burned per account.
+	AddressBurns []AddressBurned `protobuf:"bytes,9,rep,name=address_burns,json=addressBurns,proto3" json:"address_burns"`
+	// pending_params_updates holds the queue of scheduled params updates.
+	PendingParamsUpdates []ScheduledParamsUpdate `protobuf:"bytes,10,rep,name=pending_params_updates,json=pendingParamsUpdates,proto3" json:"pending_params_updates"`
+	// params_update_sequence is the id that will be assigned to the next
+	// scheduled params update.
+	ParamsUpdateSequence uint64 `protobuf:"varint,11,opt,name=params_update_sequence,json=paramsUpdateSequence,proto3" json:"params_update_sequence,omitempty"`
+	// recent_mints holds the MsgMint that count towards the mint rate limits.
+	RecentMints []RecentMint `protobuf:"bytes,12,rep,name=recent_mints,json=recentMints,proto3" json:"recent_mints"`
+	// pause_status records whether minting is paused.
+	PauseStatus PauseStatus `protobuf:"bytes,13,opt,name=pause_status,json=pauseStatus,proto3" json:"pause_status"`
+	// mint_proposals holds the mint proposals waiting for approvals.
+	MintProposals []MintProposal `protobuf:"bytes,14,rep,name=mint_proposals,json=mintProposals,proto3" json:"mint_proposals"`
+	// mint_proposal_sequence is the id that will be assigned to the next mint
+	// proposal.
+	MintProposalSequence uint64 `protobuf:"varint,15,opt,name=mint_proposal_sequence,json=mintProposalSequence,proto3" json:"mint_proposal_sequence,omitempty"`
 }
 
 func (m *GenesisState) Reset()         { *m = GenesisState{} }
@@ -70,6 +102,90 @@ func (m *GenesisState) GetParams() Params {
 	return Params{}
 }
 
+func (m *GenesisState) GetMints() []MintRecord {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintSequence() uint64 {
+	if m != nil {
+		return m.MintSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMinterUsages() []MinterUsage {
+	if m != nil {
+		return m.MinterUsages
+	}
+	return nil
+}
+
+func (m *GenesisState) GetAutoMintWatermark() AutoMintWatermark {
+	if m != nil {
+		return m.AutoMintWatermark
+	}
+	return AutoMintWatermark{}
+}
+
+func (m *GenesisState) GetAddressBurns() []AddressBurned {
+	if m != nil {
+		return m.AddressBurns
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPendingParamsUpdates() []ScheduledParamsUpdate {
+	if m != nil {
+		return m.PendingParamsUpdates
+	}
+	return nil
+}
+
+func (m *GenesisState) GetParamsUpdateSequence() uint64 {
+	if m != nil {
+		return m.ParamsUpdateSequence
+	}
+	return 0
+}
+
+func (m *GenesisState) GetRecentMints() []RecentMint {
+	if m != nil {
+		return m.RecentMints
+	}
+	return nil
+}
+
+func (m *GenesisState) GetPauseStatus() PauseStatus {
+	if m != nil {
+		return m.PauseStatus
+	}
+	return PauseStatus{}
+}
+
+func (m *GenesisState) GetMintProposals() []MintProposal {
+	if m != nil {
+		return m.MintProposals
+	}
+	return nil
+}
+
+func (m *GenesisState) GetMintProposalSequence() uint64 {
+	if m != nil {
+		return m.MintProposalSequence
+	}
+	return 0
+}
+
 func init() {
 	proto.RegisterType((*GenesisState)(nil), "gnodi.distro.v1.GenesisState")
 }
@@ -77,21 +193,48 @@ func init() {
 func init() { proto.RegisterFile("gnodi/distro/v1/genesis.proto", fileDescriptor_5f33d6fe2f542898) }
 
 var fileDescriptor_5f33d6fe2f542898 = []byte{
-	// 215 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x92, 0x4d, 0xcf, 0xcb, 0x4f,
-	0xc9, 0xd4, 0x4f, 0xc9, 0x2c, 0x2e, 0x29, 0xca, 0xd7, 0x2f, 0x33, 0xd4, 0x4f, 0x4f, 0xcd, 0x4b,
-	0x2d, 0xce, 0x2c, 0xd6, 0x2b, 0x28, 0xca, 0x2f, 0xc9, 0x17, 0xe2, 0x07, 0x4b, 0xeb, 0x41, 0xa4,
-	0xf5, 0xca, 0x0c, 0xa5, 0x04, 0x13, 0x73, 0x33, 0xf3, 0xf2, 0xf5, 0xc1, 0x24, 0x44, 0x8d, 0x94,
-	0x0c, 0xba, 0x11, 0x05, 0x89, 0x45, 0x89, 0xb9, 0x50, 0x13, 0xa4, 0x44, 0xd2, 0xf3, 0xd3, 0xf3,
-	0xc1, 0x4c, 0x7d, 0x10, 0x0b, 0x22, 0xaa, 0xe4, 0xc5, 0xc5, 0xe3, 0x0e, 0xb1, 0x28, 0xb8, 0x24,
-	0xb1, 0x24, 0x55, 0xc8, 0x8a, 0x8b, 0x0d, 0xa2, 0x4b, 0x82, 0x51, 0x81, 0x51, 0x83, 0xdb, 0x48,
-	0x5c, 0x0f, 0xcd, 0x62, 0xbd, 0x00, 0xb0, 0xb4, 0x13, 0xe7, 0x89, 0x7b, 0xf2, 0x0c, 0x2b, 0x9e,
-	0x6f, 0xd0, 0x62, 0x0c, 0x82, 0xea, 0x70, 0x72, 0x3f, 0xf1, 0x48, 0x8e, 0xf1, 0xc2, 0x23, 0x39,
-	0xc6, 0x07, 0x8f, 0xe4, 0x18, 0x27, 0x3c, 0x96, 0x63, 0xb8, 0xf0, 0x58, 0x8e, 0xe1, 0xc6, 0x63,
-	0x39, 0x86, 0x28, 0xdd, 0xf4, 0xcc, 0x92, 0x8c, 0xd2, 0x24, 0xbd, 0xe4, 0xfc, 0x5c, 0x7d, 0xb0,
-	0x79, 0xba, 0x79, 0xa9, 0x25, 0xe5, 0xf9, 0x45, 0xd9, 0x10, 0x9e, 0x7e, 0x05, 0xcc, 0xd1, 0x25,
-	0x95, 0x05, 0xa9, 0xc5, 0x49, 0x6c, 0x60, 0xb7, 0x19, 0x03, 0x02, 0x00, 0x00, 0xff, 0xff, 0x0a,
-	0xe7, 0x00, 0x76, 0x14, 0x01, 0x00, 0x00,
+	// 656 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x54, 0xcd, 0x4e, 0x1b, 0x3d,
+	0x14, 0xcd, 0x7c, 0xfc, 0x3b, 0x09, 0x88, 0xf9, 0x28, 0x9d, 0xf2, 0x33, 0x44, 0x20, 0x55, 0x51,
+	0x25, 0x66, 0x0a, 0xed, 0xaa, 0x62, 0xd3, 0x6c, 0x10, 0x55, 0x69, 0x51, 0x22, 0x54, 0xa9, 0x52,
+	0x35, 0x32, 0xb1, 0x35, 0x8c, 0x60, 0xec, 0xa9, 0xaf, 0x07, 0xca, 0x5b, 0xf4, 0x31, 0xba, 0xec,
+	0xa2, 0x0f, 0xc1, 0x12, 0xb5, 0x9b, 0xaa, 0x0b, 0x54, 0xc1, 0xa2, 0xaf, 0x51, 0xd9, 0x9e, 0x10,
+	0x0f, 0x49, 0x36, 0xdd, 0x44, 0xf1, 0x3d, 0xe7, 0x9e, 0x7b, 0x7d, 0x72, 0x62, 0xb4, 0x1a, 0x33,
+	0x4e, 0x92, 0x90, 0x24, 0x20, 0x05, 0x0f, 0xcf, 0xb6, 0xc2, 0x98, 0x32, 0x0a, 0x09, 0x04, 0x99,
+	0xe0, 0x92, 0xbb, 0x73, 0x1a, 0x0e, 0x0c, 0x1c, 0x9c, 0x6d, 0x2d, 0xcd, 0xe3, 0x34, 0x61, 0x3c,
+	0xd4, 0x9f, 0x86, 0xb3, 0xf4, 0xa8, 0xcb, 0x21, 0xe5, 0x10, 0xe9, 0x53, 0x68, 0x0e, 0x05, 0xb4,
+	0x74, 0x5f, 0xfd, 0x28, 0x17, 0x6c, 0x14, 0x96, 0x26, 0x4c, 0x16, 0xd8, 0xc6, 0x30, 0x4c, 0x0d,
+	0xc8, 0x38, 0xe0, 0xd3, 0x82, 0xb4, 0x32, 0x8c, 0x44, 0xc5, 0x28, 0x34, 0xc3, 0x02, 0xa7, 0x30,
+	0x6a, 0x80, 0x41, 0xa3, 0x3c, 0x23, 0x58, 0xd2, 0x82, 0xb4, 0x3c, 0x48, 0xca, 0xa1, 0x07, 0x2e,
+	0xc4, 0x3c, 0xe6, 0xe6, 0xca, 0xea, 0x9b, 0xa9, 0xae, 0xff, 0x98, 0x46, 0xb5, 0x5d, 0xe3, 0x60,
+	0x47, 0x62, 0x49, 0xdd, 0x17, 0x68, 0xd2, 0x48, 0x7b, 0x4e, 0xc3, 0x69, 0x56, 0xb7, 0x1f, 0x06,
+	0xf7, 0x1c, 0x0d, 0x0e, 0x34, 0xdc, 0x9a, 0xb9, 0xbc, 0x5e, 0xab, 0x7c, 0xf9, 0xf3, 0xf5, 0x89,
+	0xd3, 0x2e, 0x3a, 0xdc, 0x1d, 0x34, 0xa1, 0xae, 0x04, 0xde, 0x7f, 0x8d, 0xb1, 0x66, 0x75, 0x7b,
+	0x79, 0xa0, 0x75, 0x3f, 0x61, 0xb2, 0x4d, 0xbb, 0x5c, 0x10, 0xbb, 0xdd, 0x34, 0xb9, 0x1b, 0xa8,
+	0xae, 0x5d, 0x03, 0xfa, 0x31, 0xa7, 0xac, 0x4b, 0xbd, 0xb1, 0x86, 0xd3, 0x1c, 0x6f, 0xd7, 0x54,
+	0xb1, 0x53, 0xd4, 0xdc, 0x1d, 0x34, 0x65, 0x5c, 0x03, 0x6f, 0xbc, 0x31, 0x36, 0x74, 0xbf, 0x7d,
+	0x8d, 0xdb, 0x03, 0x7a, 0x2d, 0xee, 0x6b, 0x33, 0x82, 0x8a, 0x28, 0x07, 0x1c, 0x53, 0xf0, 0x26,
+	0xb4, 0xc6, 0xca, 0x08, 0x8d, 0x43, 0x45, 0xb2, 0x85, 0x6a, 0x69, 0xbf, 0x0e, 0xee, 0x07, 0xf4,
+	0x3f, 0xce, 0x25, 0x8f, 0xf4, 0xd6, 0xe7, 0x58, 0x52, 0x91, 0x62, 0x71, 0xe2, 0x4d, 0x6a, 0xdf,
+	0xd6, 0x07, 0x34, 0x5f, 0xe6, 0x92, 0x2b, 0xdd, 0x77, 0x3d, 0xa6, 0xad, 0x3c, 0x8f, 0xef, 0xa3,
+	0xee, 0x61, 0xb1, 0x2c, 0x89, 0x20, 0xcf, 0xb2, 0xd3, 0x0b, 0x6f, 0xaa, 0xe1, 0x34, 0x67, 0x5a,
+	0x4f, 0x55, 0xd3, 0xaf, 0xeb, 0xb5, 0x07, 0x26, 0xb8, 0x40, 0x4e, 0x82, 0x84, 0x87, 0x29, 0x96,
+	0xc7, 0xc1, 0x1e, 0x93, 0xdf, 0xbf, 0x6d, 0x22, 0x03, 0xa8, 0x93, 0xbd, 0x35, 0xe9, 0x68, 0x15,
+	0x25, 0xab, 0x42, 0xdd, 0x97, 0x9d, 0xfe, 0x57, 0x59, 0x23, 0x53, 0xc8, 0xbe, 0x41, 0x75, 0x4c,
+	0x88, 0xa0, 0x00, 0x91, 0xaa, 0x83, 0x37, 0xa3, 0xad, 0xf5, 0x07, 0x6d, 0x30, 0xac, 0x96, 0x6e,
+	0x2e, 0x99, 0x8b, 0xfb, 0x08, 0xb8, 0x31, 0x5a, 0xcc, 0x28, 0x23, 0x09, 0x8b, 0xa3, 0x52, 0xd4,
+	0xc1, 0x43, 0x5a, 0xf8, 0xf1, 0x80, 0x70, 0xa7, 0x7b, 0x4c, 0x49, 0x7e, 0x4a, 0x89, 0x09, 0xe8,
+	0xa1, 0xa6, 0xdb, 0x03, 0x16, 0x0a, 0x41, 0x1b, 0x07, 0xf7, 0x39, 0x5a, 0x2c, 0x0d, 0xe8, 0xe7,
+	0xaf, 0xaa, 0xf3, 0xb7, 0x90, 0x59, 0xf4, 0xbb, 0x1c, 0xee, 0xa1, 0x9a, 0xa0, 0x5d, 0xca, 0x64,
+	0x64, 0x12, 0x5f, 0x1b, 0x91, 0xf8, 0xb6, 0x26, 0xa9, 0x1f, 0xd6, 0xde, 0xa4, 0x2a, 0xee, 0xca,
+	0xe0, 0xbe, 0x42, 0x35, 0xfd, 0x3f, 0x8d, 0x40, 0x62, 0x99, 0x83, 0x57, 0x6f, 0x38, 0x43, 0x33,
+	0x79, 0xa0, 0x48, 0x1d, 0xcd, 0x29, 0x69, 0x65, 0xfd, 0xba, 0xfb, 0x16, 0xcd, 0x96, 0x5e, 0x1e,
+	0xf0, 0x66, 0xf5, 0x62, 0xab, 0x43, 0x13, 0x7e, 0x50, 0xb0, 0x6c, 0xb9, 0x7a, 0x6a, 0x01, 0xda,
+	0x9d, 0x92, 0x60, 0xdf, 0x9d, 0x39, 0xe3, 0x8e, 0x4d, 0xef, 0xb9, 0xd3, 0xda, 0xbd, 0xbc, 0xf1,
+	0x9d, 0xab, 0x1b, 0xdf, 0xf9, 0x7d, 0xe3, 0x3b, 0x9f, 0x6f, 0xfd, 0xca, 0xd5, 0xad, 0x5f, 0xf9,
+	0x79, 0xeb, 0x57, 0xde, 0x6f, 0xc6, 0x89, 0x3c, 0xce, 0x8f, 0x82, 0x2e, 0x4f, 0x43, 0xbd, 0xd2,
+	0x26, 0xa3, 0xf2, 0x9c, 0x8b, 0x13, 0x73, 0x0a, 0x3f, 0xf5, 0x5e, 0x2f, 0x79, 0x91, 0x51, 0x38,
+	0x9a, 0xd4, 0xaf, 0xd4, 0xb3, 0xbf, 0x03, 0x00, 0x07, 0x14, 0xe6, 0x99, 0xf6, 0x05, 0x00, 0x00,
 }
 
 func (m *GenesisState) Marshal() (dAtA []byte, err error) {
@@ -114,6 +257,159 @@ func (m *GenesisState) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.MintProposalSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintProposalSequence))
+		i--
+		dAtA[i] = 0x78
+	}
+	if len(m.MintProposals) > 0 {
+		for iNdEx := len(m.MintProposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MintProposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x72
+		}
+	}
+	{
+		size, err := m.PauseStatus.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if len(m.RecentMints) > 0 {
+		for iNdEx := len(m.RecentMints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.RecentMints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x62
+		}
+	}
+	if m.ParamsUpdateSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.ParamsUpdateSequence))
+		i--
+		dAtA[i] = 0x58
+	}
+	if len(m.PendingParamsUpdates) > 0 {
+		for iNdEx := len(m.PendingParamsUpdates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.PendingParamsUpdates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x52
+		}
+	}
+	if len(m.AddressBurns) > 0 {
+		for iNdEx := len(m.AddressBurns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.AddressBurns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x4a
+		}
+	}
+	{
+		size := m.BurnedSupply.Size()
+		i -= size
+		if _, err := m.BurnedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.MintedSupply.Size()
+		i -= size
+		if _, err := m.MintedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	{
+		size, err := m.AutoMintWatermark.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintGenesis(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x32
+	if len(m.MinterUsages) > 0 {
+		for iNdEx := len(m.MinterUsages) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.MinterUsages[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.MintSequence != 0 {
+		i = encodeVarintGenesis(dAtA, i, uint64(m.MintSequence))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintGenesis(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x12
+		}
+	}
 	{
 		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
 		if err != nil {
@@ -146,6 +442,65 @@ func (m *GenesisState) Size() (n int) {
 	_ = l
 	l = m.Params.Size()
 	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.MintSequence))
+	}
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.MinterUsages) > 0 {
+		for _, e := range m.MinterUsages {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.AutoMintWatermark.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	l = m.BurnedSupply.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.AddressBurns) > 0 {
+		for _, e := range m.AddressBurns {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if len(m.PendingParamsUpdates) > 0 {
+		for _, e := range m.PendingParamsUpdates {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.ParamsUpdateSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.ParamsUpdateSequence))
+	}
+	if len(m.RecentMints) > 0 {
+		for _, e := range m.RecentMints {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	l = m.PauseStatus.Size()
+	n += 1 + l + sovGenesis(uint64(l))
+	if len(m.MintProposals) > 0 {
+		for _, e := range m.MintProposals {
+			l = e.Size()
+			n += 1 + l + sovGenesis(uint64(l))
+		}
+	}
+	if m.MintProposalSequence != 0 {
+		n += 1 + sovGenesis(uint64(m.MintProposalSequence))
+	}
 	return n
 }
 
@@ -217,6 +572,435 @@ func (m *GenesisState) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, MintRecord{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintSequence", wireType)
+			}
+			m.MintSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinterUsages", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MinterUsages = append(m.MinterUsages, MinterUsage{})
+			if err := m.MinterUsages[len(m.MinterUsages)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMintWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoMintWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AddressBurns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AddressBurns = append(m.AddressBurns, AddressBurned{})
+			if err := m.AddressBurns[len(m.AddressBurns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PendingParamsUpdates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PendingParamsUpdates = append(m.PendingParamsUpdates, ScheduledParamsUpdate{})
+			if err := m.PendingParamsUpdates[len(m.PendingParamsUpdates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ParamsUpdateSequence", wireType)
+			}
+			m.ParamsUpdateSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ParamsUpdateSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecentMints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RecentMints = append(m.RecentMints, RecentMint{})
+			if err := m.RecentMints[len(m.RecentMints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PauseStatus", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PauseStatus.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintProposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthGenesis
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintProposals = append(m.MintProposals, MintProposal{})
+			if err := m.MintProposals[len(m.MintProposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintProposalSequence", wireType)
+			}
+			m.MintProposalSequence = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowGenesis
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintProposalSequence |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipGenesis(dAtA[iNdEx:])
@@ -3,8 +3,11 @@ package types_test
 import (
 	"os"
 	"testing"
+	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/gnodi-network/gnodi/testutil/sample"
 	"github.com/gnodi-network/gnodi/x/distro/types"
 	"github.com/stretchr/testify/require"
 )
@@ -30,34 +33,245 @@ func TestGenesisState_Validate(t *testing.T) {
 			desc: "fully configured genesis is valid",
 			genState: &types.GenesisState{
 				Params: types.NewParams(
-					"gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu",
 					"gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu",
 					"uGNOD",
-					35_000_000_000_000_000,
+					math.NewInt(35_000_000_000_000_000),
 					"2025-07-22",
 					12,
 				),
+				Minters: []types.Minter{
+					types.NewMinter("gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", types.NewMinterQuota(math.LegacyNewDecWithPrec(5, 1), math.NewInt(1_000))),
+				},
+				MinterUsages: []types.MinterUsage{
+					{Address: "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", HalvingPeriod: 1, Amount: math.NewInt(500)},
+				},
 			},
 			valid: true,
 		},
 		{
 			desc: "empty addresses are allowed in genesis",
 			genState: &types.GenesisState{
-				Params: types.NewParams("", "", "uGNOD", 35_000_000_000_000_000, "2025-07-22", 12),
+				Params: types.NewParams("", "uGNOD", math.NewInt(35_000_000_000_000_000), "2025-07-22", 12),
 			},
 			valid: true,
 		},
 		{
-			desc: "invalid minting address is rejected",
+			desc: "deprecated minting address is rejected",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					MintingAddress:        "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu",
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "omitted max supply is rejected",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					Denom:                 "uGNOD",
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "zero max supply is rejected",
+			genState: &types.GenesisState{
+				Params: types.NewParams("", "uGNOD", math.ZeroInt(), "2025-07-22", 12),
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid minter address is rejected",
+			genState: &types.GenesisState{
+				Params:  types.DefaultParams(),
+				Minters: []types.Minter{types.NewMinter("notanaddress", types.UnlimitedMinterQuota())},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate minter is rejected",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				Minters: []types.Minter{
+					types.NewMinter("gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", types.UnlimitedMinterQuota()),
+					types.NewMinter("gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", types.UnlimitedMinterQuota()),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "minter share above one is rejected",
 			genState: &types.GenesisState{
-				Params: types.NewParams("notanaddress", "", "uGNOD", 35_000_000_000_000_000, "2025-07-22", 12),
+				Params: types.DefaultParams(),
+				Minters: []types.Minter{
+					types.NewMinter("gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", types.NewMinterQuota(math.LegacyNewDec(2), math.ZeroInt())),
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate minter usage is rejected",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MinterUsages: []types.MinterUsage{
+					{Address: "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", HalvingPeriod: 1, Amount: math.NewInt(1)},
+					{Address: "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", HalvingPeriod: 1, Amount: math.NewInt(2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "mint ledger with sequence ahead of ids is valid",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Mints:        []types.MintRecord{{Id: 0}, {Id: 1}},
+				MintSequence: 2,
+			},
+			valid: true,
+		},
+		{
+			desc: "duplicate mint record id is rejected",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Mints:        []types.MintRecord{{Id: 0}, {Id: 0}},
+				MintSequence: 2,
+			},
+			valid: false,
+		},
+		{
+			desc: "mint record id not below sequence is rejected",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				Mints:        []types.MintRecord{{Id: 1}},
+				MintSequence: 1,
 			},
 			valid: false,
 		},
 		{
 			desc: "empty denom is rejected",
 			genState: &types.GenesisState{
-				Params: types.NewParams("", "", "", 35_000_000_000_000_000, "2025-07-22", 12),
+				Params: types.NewParams("", "", math.NewInt(35_000_000_000_000_000), "2025-07-22", 12),
+			},
+			valid: false,
+		},
+		{
+			desc: "negative minted supply is rejected",
+			genState: &types.GenesisState{
+				Params:       types.DefaultParams(),
+				MintedSupply: math.NewInt(-1),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate burned amount is rejected",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				AddressBurns: []types.AddressBurned{
+					{Address: "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", Amount: math.NewInt(1)},
+					{Address: "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", Amount: math.NewInt(2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "unknown max supply basis is rejected",
+			genState: &types.GenesisState{
+				Params: types.Params{
+					Denom:                 "uGNOD",
+					MaxSupply:             math.NewInt(35_000_000_000_000_000),
+					MaxSupplyBasis:        types.SupplyBasis(42),
+					DistributionStartDate: "2025-07-22",
+					MonthsInHalvingPeriod: 12,
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled params update id not below sequence is rejected",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				PendingParamsUpdates: []types.ScheduledParamsUpdate{{Id: 1, ActivationHeight: 10}},
+				ParamsUpdateSequence: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "mint window without max window amount is rejected",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.MintWindow = time.Hour
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "duplicate recent mint is rejected",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				RecentMints: []types.RecentMint{
+					{Id: 1, Amount: math.NewInt(1)},
+					{Id: 1, Amount: math.NewInt(2)},
+				},
+			},
+			valid: false,
+		},
+		{
+			desc: "scheduled params update without activation is rejected",
+			genState: &types.GenesisState{
+				Params:               types.DefaultParams(),
+				PendingParamsUpdates: []types.ScheduledParamsUpdate{{Id: 0}},
+				ParamsUpdateSequence: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "invalid guardian is rejected",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.Guardian = "invalid"
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "mint approval threshold above the approvers is rejected",
+			genState: &types.GenesisState{
+				Params: func() types.Params {
+					params := types.DefaultParams()
+					params.MintApprovers = []string{sample.AccAddress()}
+					params.MintApprovalThreshold = 2
+					params.MintProposalTtl = time.Hour
+					return params
+				}(),
+			},
+			valid: false,
+		},
+		{
+			desc: "mint proposal id not below sequence is rejected",
+			genState: &types.GenesisState{
+				Params: types.DefaultParams(),
+				MintProposals: []types.MintProposal{
+					{Id: 1, Proposer: sample.AccAddress(), Amount: math.NewInt(1)},
+				},
+				MintProposalSequence: 1,
+			},
+			valid: false,
+		},
+		{
+			desc: "pause status without signer is rejected",
+			genState: &types.GenesisState{
+				Params:      types.DefaultParams(),
+				PauseStatus: types.PauseStatus{Paused: true},
 			},
 			valid: false,
 		},
@@ -13,7 +13,63 @@ const (
 	// It should be synced with the gov module's name if it is ever changed.
 	// See: https://github.com/cosmos/cosmos-sdk/blob/v0.52.0-beta.2/x/gov/types/keys.go#L9
 	GovModuleName = "gov"
+
+	// PrecompileAddress is the address of the distro EVM precompile. It sits
+	// after the cosmos/evm static precompiles (0x...0800 to 0x...0806).
+	PrecompileAddress = "0x0000000000000000000000000000000000000900"
 )
 
-// ParamsKey is the prefix to retrieve all Params
-var ParamsKey = collections.NewPrefix("p_distro")
+var (
+	// ParamsKey is the prefix to retrieve all Params
+	ParamsKey = collections.NewPrefix("p_distro")
+
+	// MintsKey is the prefix of the mint ledger, keyed by sequence number.
+	MintsKey = collections.NewPrefix("mint_ledger")
+	// MintSequenceKey is the key of the mint ledger sequence.
+	MintSequenceKey = collections.NewPrefix("mint_seq")
+	// MintsBySignerKey is the prefix of the mint ledger signer index.
+	MintsBySignerKey = collections.NewPrefix("mint_by_signer")
+	// MintsByHeightKey is the prefix of the mint ledger block height index.
+	MintsByHeightKey = collections.NewPrefix("mint_by_height")
+
+	// MintersKey is the prefix of the minter registry.
+	MintersKey = collections.NewPrefix("minters")
+	// MinterUsageKey is the prefix of the per-minter, per-period usage.
+	MinterUsageKey = collections.NewPrefix("minter_usage")
+
+	// AutoMintWatermarkKey is the key of the automatic minting watermark.
+	AutoMintWatermarkKey = collections.NewPrefix("auto_mint_watermark")
+
+	// MintedSupplyKey is the key of the cumulative amount minted by the module.
+	MintedSupplyKey = collections.NewPrefix("minted_supply")
+
+	// BurnedSupplyKey is the key of the cumulative amount burned through the
+	// module.
+	BurnedSupplyKey = collections.NewPrefix("burned_supply")
+	// AddressBurnedKey is the prefix of the cumulative amount burned per
+	// account.
+	AddressBurnedKey = collections.NewPrefix("address_burned")
+
+	// PendingParamsUpdatesKey is the prefix of the scheduled params updates,
+	// keyed by sequence number.
+	PendingParamsUpdatesKey = collections.NewPrefix("pending_params_updates")
+	// ParamsUpdateSequenceKey is the key of the scheduled params update
+	// sequence.
+	ParamsUpdateSequenceKey = collections.NewPrefix("params_update_seq")
+
+	// RecentMintsKey is the prefix of the MsgMint that count towards the mint
+	// rate limits, keyed by mint ledger id.
+	RecentMintsKey = collections.NewPrefix("recent_mints")
+
+	// PauseStatusKey is the key of the minting pause status.
+	PauseStatusKey = collections.NewPrefix("pause_status")
+
+	// MintProposalsKey is the prefix of the mint proposals waiting for
+	// approvals, keyed by sequence number.
+	MintProposalsKey = collections.NewPrefix("mint_proposals")
+	// MintProposalsByExpiryKey is the prefix of the mint proposal expiry
+	// time index.
+	MintProposalsByExpiryKey = collections.NewPrefix("mint_proposal_by_expiry")
+	// MintProposalSequenceKey is the key of the mint proposal sequence.
+	MintProposalSequenceKey = collections.NewPrefix("mint_proposal_seq")
+)
@@ -0,0 +1,17 @@
+package types
+
+import "cosmossdk.io/math"
+
+func NewMsgBurn(signer string, amount math.Int) *MsgBurn {
+	return &MsgBurn{
+		Signer: signer,
+		Amount: amount,
+	}
+}
+
+func NewMsgBurnFromTreasury(authority string, amount math.Int) *MsgBurnFromTreasury {
+	return &MsgBurnFromTreasury{
+		Authority: authority,
+		Amount:    amount,
+	}
+}
@@ -1,8 +1,50 @@
 package types
 
-func NewMsgMint(amount uint64, signer string) *MsgMint {
+import "cosmossdk.io/math"
+
+func NewMsgMint(amount math.Int, signer string) *MsgMint {
 	return &MsgMint{
 		Amount: amount,
 		Signer: signer,
 	}
 }
+
+// WithRecipient sends the whole mint to recipient, which must be one of the
+// mint destinations.
+func (msg *MsgMint) WithRecipient(recipient string) *MsgMint {
+	msg.Recipient = recipient
+	return msg
+}
+
+// WithReference stores reference in the mint record.
+func (msg *MsgMint) WithReference(reference string) *MsgMint {
+	msg.Reference = reference
+	return msg
+}
+
+func NewMsgProposeMint(proposer string, amount math.Int) *MsgProposeMint {
+	return &MsgProposeMint{
+		Proposer: proposer,
+		Amount:   amount,
+	}
+}
+
+// WithRecipient sends the whole mint to recipient, which must be one of the
+// mint destinations.
+func (msg *MsgProposeMint) WithRecipient(recipient string) *MsgProposeMint {
+	msg.Recipient = recipient
+	return msg
+}
+
+// WithReference stores reference in the mint record.
+func (msg *MsgProposeMint) WithReference(reference string) *MsgProposeMint {
+	msg.Reference = reference
+	return msg
+}
+
+func NewMsgApproveMint(approver string, id uint64) *MsgApproveMint {
+	return &MsgApproveMint{
+		Approver: approver,
+		Id:       id,
+	}
+}
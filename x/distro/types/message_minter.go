@@ -0,0 +1,23 @@
+package types
+
+func NewMsgAddMinter(authority string, minter Minter) *MsgAddMinter {
+	return &MsgAddMinter{
+		Authority: authority,
+		Minter:    minter,
+	}
+}
+
+func NewMsgRemoveMinter(authority string, address string) *MsgRemoveMinter {
+	return &MsgRemoveMinter{
+		Authority: authority,
+		Address:   address,
+	}
+}
+
+func NewMsgSetMinterQuota(authority string, address string, quota MinterQuota) *MsgSetMinterQuota {
+	return &MsgSetMinterQuota{
+		Authority: authority,
+		Address:   address,
+		Quota:     quota,
+	}
+}
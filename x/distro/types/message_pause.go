@@ -0,0 +1,14 @@
+package types
+
+func NewMsgPause(signer, reason string) *MsgPause {
+	return &MsgPause{
+		Signer: signer,
+		Reason: reason,
+	}
+}
+
+func NewMsgUnpause(authority string) *MsgUnpause {
+	return &MsgUnpause{
+		Authority: authority,
+	}
+}
@@ -0,0 +1,47 @@
+package types
+
+import (
+	"time"
+
+	gogotypes "github.com/cosmos/gogoproto/types"
+)
+
+func NewMsgUpdateParamsPartial(authority string, params Params, paths ...string) *MsgUpdateParamsPartial {
+	return &MsgUpdateParamsPartial{
+		Authority:  authority,
+		Params:     params,
+		UpdateMask: &gogotypes.FieldMask{Paths: paths},
+	}
+}
+
+// NewMsgScheduleParamsUpdate schedules params to replace the current params
+// at the given height. Use WithActivationTime to activate at a block time
+// instead, and WithUpdateMask to only update some fields.
+func NewMsgScheduleParamsUpdate(authority string, params Params, activationHeight int64) *MsgScheduleParamsUpdate {
+	return &MsgScheduleParamsUpdate{
+		Authority:        authority,
+		Params:           params,
+		ActivationHeight: activationHeight,
+	}
+}
+
+// WithActivationTime makes the update activate at the first block whose time
+// is at or after t, clearing any activation height.
+func (msg *MsgScheduleParamsUpdate) WithActivationTime(t time.Time) *MsgScheduleParamsUpdate {
+	msg.ActivationHeight = 0
+	msg.ActivationTime = &t
+	return msg
+}
+
+// WithUpdateMask restricts the update to the given params fields.
+func (msg *MsgScheduleParamsUpdate) WithUpdateMask(paths ...string) *MsgScheduleParamsUpdate {
+	msg.UpdateMask = &gogotypes.FieldMask{Paths: paths}
+	return msg
+}
+
+func NewMsgCancelParamsUpdate(authority string, id uint64) *MsgCancelParamsUpdate {
+	return &MsgCancelParamsUpdate{
+		Authority: authority,
+		Id:        id,
+	}
+}
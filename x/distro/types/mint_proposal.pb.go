@@ -0,0 +1,681 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gnodi/distro/v1/mint_proposal.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MintProposal is a mint waiting for mint_approval_threshold approvals from
+// the mint_approvers. It is executed as soon as the threshold is reached, and
+// dropped once it expires.
+type MintProposal struct {
+	// id is the sequence number of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// proposer is the minter that proposed the mint. The mint is executed on
+	// its behalf and counts against its quota.
+	Proposer string `protobuf:"bytes,2,opt,name=proposer,proto3" json:"proposer,omitempty"`
+	// amount is the number of base units to mint.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+	// approvals lists the approvers that approved the proposal, in approval
+	// order.
+	Approvals []string `protobuf:"bytes,4,rep,name=approvals,proto3" json:"approvals,omitempty"`
+	// submit_height is the height of the block the proposal was submitted at.
+	SubmitHeight int64 `protobuf:"varint,5,opt,name=submit_height,json=submitHeight,proto3" json:"submit_height,omitempty"`
+	// expires_at is the block time from which the proposal can no longer be
+	// approved.
+	ExpiresAt time.Time `protobuf:"bytes,6,opt,name=expires_at,json=expiresAt,proto3,stdtime" json:"expires_at"`
+	// recipient is the mint destination the mint is sent to, if any.
+	Recipient string `protobuf:"bytes,7,opt,name=recipient,proto3" json:"recipient,omitempty"`
+	// reference is the free-form reference stored in the mint record.
+	Reference string `protobuf:"bytes,8,opt,name=reference,proto3" json:"reference,omitempty"`
+}
+
+func (m *MintProposal) Reset()         { *m = MintProposal{} }
+func (m *MintProposal) String() string { return proto.CompactTextString(m) }
+func (*MintProposal) ProtoMessage()    {}
+func (*MintProposal) Descriptor() ([]byte, []int) {
+	return fileDescriptor_1284ffbf86542329, []int{0}
+}
+func (m *MintProposal) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MintProposal) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MintProposal.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MintProposal) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MintProposal.Merge(m, src)
+}
+func (m *MintProposal) XXX_Size() int {
+	return m.Size()
+}
+func (m *MintProposal) XXX_DiscardUnknown() {
+	xxx_messageInfo_MintProposal.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MintProposal proto.InternalMessageInfo
+
+func (m *MintProposal) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *MintProposal) GetProposer() string {
+	if m != nil {
+		return m.Proposer
+	}
+	return ""
+}
+
+func (m *MintProposal) GetApprovals() []string {
+	if m != nil {
+		return m.Approvals
+	}
+	return nil
+}
+
+func (m *MintProposal) GetSubmitHeight() int64 {
+	if m != nil {
+		return m.SubmitHeight
+	}
+	return 0
+}
+
+func (m *MintProposal) GetExpiresAt() time.Time {
+	if m != nil {
+		return m.ExpiresAt
+	}
+	return time.Time{}
+}
+
+func (m *MintProposal) GetRecipient() string {
+	if m != nil {
+		return m.Recipient
+	}
+	return ""
+}
+
+func (m *MintProposal) GetReference() string {
+	if m != nil {
+		return m.Reference
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*MintProposal)(nil), "gnodi.distro.v1.MintProposal")
+}
+
+func init() {
+	proto.RegisterFile("gnodi/distro/v1/mint_proposal.proto", fileDescriptor_1284ffbf86542329)
+}
+
+var fileDescriptor_1284ffbf86542329 = []byte{
+	// 435 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x84, 0x52, 0x31, 0x6f, 0x13, 0x31,
+	0x18, 0x8d, 0x93, 0x12, 0x1a, 0xd3, 0x82, 0x38, 0x15, 0xc9, 0x44, 0xe8, 0x12, 0xd1, 0x25, 0x42,
+	0x8a, 0x4d, 0x01, 0xb1, 0x37, 0x0b, 0xe9, 0x80, 0x84, 0x0e, 0x26, 0x96, 0xe8, 0x92, 0x73, 0x2f,
+	0x56, 0x63, 0x7f, 0x96, 0xfd, 0x25, 0x94, 0x7f, 0xd1, 0x9d, 0x3f, 0xc0, 0xc8, 0xd0, 0x1f, 0xd1,
+	0xb1, 0xea, 0x84, 0x18, 0x0a, 0x4a, 0x06, 0xfe, 0x06, 0x8a, 0x7d, 0x21, 0x1b, 0x2c, 0xa7, 0x7b,
+	0xcf, 0xef, 0xd9, 0xef, 0x7b, 0xfa, 0xe8, 0x61, 0x69, 0xa0, 0x50, 0xa2, 0x50, 0x1e, 0x1d, 0x88,
+	0xc5, 0x91, 0xd0, 0xca, 0xe0, 0xc8, 0x3a, 0xb0, 0xe0, 0xf3, 0x19, 0xb7, 0x0e, 0x10, 0x92, 0x07,
+	0x41, 0xc4, 0xa3, 0x88, 0x2f, 0x8e, 0xda, 0x0f, 0x73, 0xad, 0x0c, 0x88, 0xf0, 0x8d, 0x9a, 0xf6,
+	0xe3, 0x09, 0x78, 0x0d, 0x7e, 0x14, 0x90, 0x88, 0xa0, 0x3a, 0x3a, 0x28, 0xa1, 0x84, 0xc8, 0xaf,
+	0xff, 0x2a, 0xb6, 0x53, 0x02, 0x94, 0x33, 0x29, 0x02, 0x1a, 0xcf, 0x4f, 0x05, 0x2a, 0x2d, 0x3d,
+	0xe6, 0xda, 0x46, 0xc1, 0xd3, 0x2f, 0x0d, 0xba, 0xf7, 0x56, 0x19, 0x7c, 0x57, 0x85, 0x49, 0xee,
+	0xd3, 0xba, 0x2a, 0x18, 0xe9, 0x92, 0xde, 0x4e, 0x56, 0x57, 0x45, 0xf2, 0x8a, 0xee, 0xc6, 0xa0,
+	0xd2, 0xb1, 0x7a, 0x97, 0xf4, 0x5a, 0x03, 0x76, 0x73, 0xd9, 0x3f, 0xa8, 0xde, 0x3e, 0x2e, 0x0a,
+	0x27, 0xbd, 0x7f, 0x8f, 0x4e, 0x99, 0x32, 0xfb, 0xab, 0x4c, 0x86, 0xb4, 0x99, 0x6b, 0x98, 0x1b,
+	0x64, 0x8d, 0xe0, 0x79, 0x7e, 0x75, 0xdb, 0xa9, 0xfd, 0xb8, 0xed, 0x3c, 0x8a, 0x3e, 0x5f, 0x9c,
+	0x71, 0x05, 0x42, 0xe7, 0x38, 0xe5, 0x27, 0x06, 0x6f, 0x2e, 0xfb, 0xb4, 0xba, 0xf0, 0xc4, 0xe0,
+	0xd7, 0xdf, 0xdf, 0x9e, 0x91, 0xac, 0xf2, 0x27, 0xaf, 0x69, 0x2b, 0xb7, 0xd6, 0xc1, 0x22, 0x9f,
+	0x79, 0xb6, 0xd3, 0x6d, 0xfc, 0x33, 0xc0, 0x56, 0x9a, 0x1c, 0xd2, 0x7d, 0x3f, 0x1f, 0x6b, 0x85,
+	0xa3, 0xa9, 0x54, 0xe5, 0x14, 0xd9, 0x9d, 0x2e, 0xe9, 0x35, 0xb2, 0xbd, 0x48, 0x0e, 0x03, 0x97,
+	0x0c, 0x29, 0x95, 0xe7, 0x56, 0x39, 0xe9, 0x47, 0x39, 0xb2, 0x66, 0x97, 0xf4, 0xee, 0xbd, 0x68,
+	0xf3, 0xd8, 0x19, 0xdf, 0x74, 0xc6, 0x3f, 0x6c, 0x3a, 0x1b, 0xec, 0xaf, 0xc7, 0xb8, 0xf8, 0xd9,
+	0x21, 0x31, 0x63, 0xab, 0x32, 0x1f, 0x87, 0x98, 0x4e, 0x4e, 0x94, 0x55, 0xd2, 0x20, 0xbb, 0xfb,
+	0x9f, 0x9e, 0xb6, 0xd2, 0xe4, 0xc9, 0xda, 0x77, 0x2a, 0x9d, 0x34, 0x13, 0xc9, 0x76, 0xd7, 0xbe,
+	0x6c, 0x4b, 0x0c, 0xde, 0x5c, 0x2d, 0x53, 0x72, 0xbd, 0x4c, 0xc9, 0xaf, 0x65, 0x4a, 0x2e, 0x56,
+	0x69, 0xed, 0x7a, 0x95, 0xd6, 0xbe, 0xaf, 0xd2, 0xda, 0xc7, 0x7e, 0xa9, 0x70, 0x3a, 0x1f, 0xf3,
+	0x09, 0x68, 0x11, 0x16, 0xa7, 0x6f, 0x24, 0x7e, 0x02, 0x77, 0x16, 0x91, 0x38, 0xdf, 0x6c, 0x1b,
+	0x7e, 0xb6, 0xd2, 0x8f, 0x9b, 0x61, 0x98, 0x97, 0x7f, 0x06, 0x00, 0x82, 0x31, 0xff, 0xdf, 0x8a,
+	0x02, 0x00, 0x00,
+}
+
+func (m *MintProposal) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MintProposal) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MintProposal) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Reference) > 0 {
+		i -= len(m.Reference)
+		copy(dAtA[i:], m.Reference)
+		i = encodeVarintMintProposal(dAtA, i, uint64(len(m.Reference)))
+		i--
+		dAtA[i] = 0x42
+	}
+	if len(m.Recipient) > 0 {
+		i -= len(m.Recipient)
+		copy(dAtA[i:], m.Recipient)
+		i = encodeVarintMintProposal(dAtA, i, uint64(len(m.Recipient)))
+		i--
+		dAtA[i] = 0x3a
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.ExpiresAt, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintMintProposal(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x32
+	if m.SubmitHeight != 0 {
+		i = encodeVarintMintProposal(dAtA, i, uint64(m.SubmitHeight))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.Approvals) > 0 {
+		for iNdEx := len(m.Approvals) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Approvals[iNdEx])
+			copy(dAtA[i:], m.Approvals[iNdEx])
+			i = encodeVarintMintProposal(dAtA, i, uint64(len(m.Approvals[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMintProposal(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Proposer) > 0 {
+		i -= len(m.Proposer)
+		copy(dAtA[i:], m.Proposer)
+		i = encodeVarintMintProposal(dAtA, i, uint64(len(m.Proposer)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Id != 0 {
+		i = encodeVarintMintProposal(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMintProposal(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMintProposal(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MintProposal) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovMintProposal(uint64(m.Id))
+	}
+	l = len(m.Proposer)
+	if l > 0 {
+		n += 1 + l + sovMintProposal(uint64(l))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMintProposal(uint64(l))
+	if len(m.Approvals) > 0 {
+		for _, s := range m.Approvals {
+			l = len(s)
+			n += 1 + l + sovMintProposal(uint64(l))
+		}
+	}
+	if m.SubmitHeight != 0 {
+		n += 1 + sovMintProposal(uint64(m.SubmitHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.ExpiresAt)
+	n += 1 + l + sovMintProposal(uint64(l))
+	l = len(m.Recipient)
+	if l > 0 {
+		n += 1 + l + sovMintProposal(uint64(l))
+	}
+	l = len(m.Reference)
+	if l > 0 {
+		n += 1 + l + sovMintProposal(uint64(l))
+	}
+	return n
+}
+
+func sovMintProposal(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMintProposal(x uint64) (n int) {
+	return sovMintProposal(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MintProposal) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMintProposal
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MintProposal: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MintProposal: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Approvals", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Approvals = append(m.Approvals, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SubmitHeight", wireType)
+			}
+			m.SubmitHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SubmitHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpiresAt", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.ExpiresAt, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipient", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipient = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reference", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reference = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMintProposal(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMintProposal
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMintProposal(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMintProposal
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMintProposal
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMintProposal
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMintProposal
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMintProposal
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMintProposal        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMintProposal          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMintProposal = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,63 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewMinter creates a new Minter instance.
+func NewMinter(address string, quota MinterQuota) Minter {
+	return Minter{
+		Address: address,
+		Quota:   quota,
+	}
+}
+
+// Validate validates the minter.
+func (m Minter) Validate() error {
+	if _, err := sdk.AccAddressFromBech32(m.Address); err != nil {
+		return fmt.Errorf("invalid minter address: %w", err)
+	}
+	return m.Quota.Validate()
+}
+
+// NewMinterQuota creates a new MinterQuota instance.
+func NewMinterQuota(share math.LegacyDec, periodLimit math.Int) MinterQuota {
+	return MinterQuota{
+		Share:       share,
+		PeriodLimit: periodLimit,
+	}
+}
+
+// UnlimitedMinterQuota returns a quota that imposes no limit on the minter.
+func UnlimitedMinterQuota() MinterQuota {
+	return NewMinterQuota(math.LegacyZeroDec(), math.ZeroInt())
+}
+
+// HasShare reports whether the quota limits the minter to a share of the
+// cumulative distributable cap.
+func (q MinterQuota) HasShare() bool {
+	return !q.Share.IsNil() && q.Share.IsPositive()
+}
+
+// HasPeriodLimit reports whether the quota limits the amount the minter may
+// mint per halving period.
+func (q MinterQuota) HasPeriodLimit() bool {
+	return !q.PeriodLimit.IsNil() && q.PeriodLimit.IsPositive()
+}
+
+// Validate validates the quota.
+func (q MinterQuota) Validate() error {
+	if !q.PeriodLimit.IsNil() && q.PeriodLimit.IsNegative() {
+		return fmt.Errorf("minter period limit cannot be negative, got %s", q.PeriodLimit)
+	}
+	if q.Share.IsNil() {
+		return nil
+	}
+	if q.Share.IsNegative() || q.Share.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("minter share must be between 0 and 1, got %s", q.Share)
+	}
+	return nil
+}
@@ -0,0 +1,865 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gnodi/distro/v1/minter.proto
+
+package types
+
+import (
+	cosmossdk_io_math "cosmossdk.io/math"
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// MinterQuota bounds how much a single minter may mint. Zero-valued fields
+// impose no limit; when both are set both apply.
+type MinterQuota struct {
+	// share is the fraction of the cumulative distributable cap the minter may
+	// mint in total, between 0 and 1.
+	Share cosmossdk_io_math.LegacyDec `protobuf:"bytes,1,opt,name=share,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"share"`
+	// period_limit is the absolute amount the minter may mint per halving
+	// period.
+	PeriodLimit cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=period_limit,json=periodLimit,proto3,customtype=cosmossdk.io/math.Int" json:"period_limit"`
+}
+
+func (m *MinterQuota) Reset()         { *m = MinterQuota{} }
+func (m *MinterQuota) String() string { return proto.CompactTextString(m) }
+func (*MinterQuota) ProtoMessage()    {}
+func (*MinterQuota) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a9d467aad00d8b, []int{0}
+}
+func (m *MinterQuota) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterQuota) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterQuota.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterQuota) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterQuota.Merge(m, src)
+}
+func (m *MinterQuota) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterQuota) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterQuota.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterQuota proto.InternalMessageInfo
+
+// Minter is an address authorized to execute MsgMint.
+type Minter struct {
+	// address is the minter address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// quota bounds the amount the minter may mint.
+	Quota MinterQuota `protobuf:"bytes,2,opt,name=quota,proto3" json:"quota"`
+}
+
+func (m *Minter) Reset()         { *m = Minter{} }
+func (m *Minter) String() string { return proto.CompactTextString(m) }
+func (*Minter) ProtoMessage()    {}
+func (*Minter) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a9d467aad00d8b, []int{1}
+}
+func (m *Minter) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Minter) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Minter.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Minter) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Minter.Merge(m, src)
+}
+func (m *Minter) XXX_Size() int {
+	return m.Size()
+}
+func (m *Minter) XXX_DiscardUnknown() {
+	xxx_messageInfo_Minter.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Minter proto.InternalMessageInfo
+
+func (m *Minter) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Minter) GetQuota() MinterQuota {
+	if m != nil {
+		return m.Quota
+	}
+	return MinterQuota{}
+}
+
+// MinterUsage is the amount a minter minted during a halving period.
+type MinterUsage struct {
+	// address is the minter address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// halving_period is the 1-based halving period.
+	HalvingPeriod uint64 `protobuf:"varint,2,opt,name=halving_period,json=halvingPeriod,proto3" json:"halving_period,omitempty"`
+	// amount is the amount minted by the minter during the period.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *MinterUsage) Reset()         { *m = MinterUsage{} }
+func (m *MinterUsage) String() string { return proto.CompactTextString(m) }
+func (*MinterUsage) ProtoMessage()    {}
+func (*MinterUsage) Descriptor() ([]byte, []int) {
+	return fileDescriptor_b2a9d467aad00d8b, []int{2}
+}
+func (m *MinterUsage) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *MinterUsage) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_MinterUsage.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *MinterUsage) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_MinterUsage.Merge(m, src)
+}
+func (m *MinterUsage) XXX_Size() int {
+	return m.Size()
+}
+func (m *MinterUsage) XXX_DiscardUnknown() {
+	xxx_messageInfo_MinterUsage.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_MinterUsage proto.InternalMessageInfo
+
+func (m *MinterUsage) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *MinterUsage) GetHalvingPeriod() uint64 {
+	if m != nil {
+		return m.HalvingPeriod
+	}
+	return 0
+}
+
+func init() {
+	proto.RegisterType((*MinterQuota)(nil), "gnodi.distro.v1.MinterQuota")
+	proto.RegisterType((*Minter)(nil), "gnodi.distro.v1.Minter")
+	proto.RegisterType((*MinterUsage)(nil), "gnodi.distro.v1.MinterUsage")
+}
+
+func init() { proto.RegisterFile("gnodi/distro/v1/minter.proto", fileDescriptor_b2a9d467aad00d8b) }
+
+var fileDescriptor_b2a9d467aad00d8b = []byte{
+	// 404 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x92, 0xcf, 0xaa, 0x13, 0x31,
+	0x14, 0xc6, 0x67, 0xd4, 0x5b, 0xb9, 0xa9, 0x7f, 0x70, 0xa8, 0x30, 0xd6, 0x32, 0x95, 0x82, 0x20,
+	0xc2, 0x24, 0xb6, 0x82, 0x3b, 0x17, 0x96, 0x82, 0x16, 0x2a, 0x68, 0x8b, 0x1b, 0x37, 0x25, 0x9d,
+	0x09, 0x99, 0xd0, 0x4e, 0x52, 0x93, 0xb4, 0x5a, 0x7c, 0x09, 0x1f, 0xc3, 0x65, 0x91, 0x3e, 0x44,
+	0x97, 0xa5, 0x2b, 0x71, 0x51, 0xa4, 0x5d, 0xf8, 0x1a, 0x32, 0x49, 0x2a, 0xc5, 0xbb, 0xeb, 0x66,
+	0x98, 0x73, 0xbe, 0x9c, 0x5f, 0xbe, 0xf3, 0x11, 0x50, 0xa3, 0x5c, 0xa4, 0x0c, 0xa5, 0x4c, 0x69,
+	0x29, 0xd0, 0xbc, 0x89, 0x72, 0xc6, 0x35, 0x91, 0x70, 0x2a, 0x85, 0x16, 0xc1, 0x5d, 0xa3, 0x42,
+	0xab, 0xc2, 0x79, 0xb3, 0x7a, 0x0f, 0xe7, 0x8c, 0x0b, 0x64, 0xbe, 0xf6, 0x4c, 0xf5, 0x41, 0x22,
+	0x54, 0x2e, 0xd4, 0xd0, 0x54, 0xc8, 0x16, 0x4e, 0xaa, 0x50, 0x41, 0x85, 0xed, 0x17, 0x7f, 0xb6,
+	0xdb, 0x58, 0xfa, 0xa0, 0xfc, 0xd6, 0xdc, 0xf2, 0x7e, 0x26, 0x34, 0x0e, 0x7a, 0xe0, 0x42, 0x65,
+	0x58, 0x92, 0xd0, 0x7f, 0xe4, 0x3f, 0xb9, 0x6c, 0xbf, 0x58, 0xef, 0xea, 0xde, 0xaf, 0x5d, 0xfd,
+	0xa1, 0x45, 0xa9, 0x74, 0x0c, 0x99, 0x40, 0x39, 0xd6, 0x19, 0xec, 0x11, 0x8a, 0x93, 0x45, 0x87,
+	0x24, 0xdb, 0x55, 0x0c, 0xdc, 0x4d, 0x1d, 0x92, 0x7c, 0xff, 0xb3, 0x7c, 0xea, 0xf7, 0x2d, 0x24,
+	0x18, 0x80, 0x5b, 0x53, 0x22, 0x99, 0x48, 0x87, 0x13, 0x96, 0x33, 0x1d, 0x5e, 0x33, 0xd0, 0x67,
+	0x0e, 0x7a, 0xff, 0x2a, 0xb4, 0xcb, 0xf5, 0x09, 0xae, 0xcb, 0xb5, 0xc5, 0x95, 0x2d, 0xa5, 0x57,
+	0x40, 0x1a, 0x5f, 0x41, 0xc9, 0x3a, 0x0e, 0x5a, 0xe0, 0x26, 0x4e, 0x53, 0x49, 0x94, 0x72, 0x76,
+	0xc3, 0xed, 0x2a, 0xae, 0xb8, 0xe1, 0x57, 0x56, 0x19, 0x68, 0xc9, 0x38, 0xed, 0x1f, 0x0f, 0x06,
+	0x2f, 0xc1, 0xc5, 0xa7, 0x62, 0x53, 0xe3, 0xa5, 0xdc, 0xaa, 0xc1, 0xff, 0x52, 0x85, 0x27, 0x69,
+	0xb4, 0x2f, 0x0b, 0xa7, 0x6e, 0x23, 0x33, 0xd5, 0xf8, 0xf1, 0x2f, 0xaf, 0x0f, 0x0a, 0x53, 0x72,
+	0x96, 0x85, 0xc7, 0xe0, 0x4e, 0x86, 0x27, 0x73, 0xc6, 0xe9, 0xd0, 0xee, 0x65, 0xbc, 0xdc, 0xe8,
+	0xdf, 0x76, 0xdd, 0x77, 0xa6, 0x19, 0xbc, 0x01, 0x25, 0x9c, 0x8b, 0x19, 0xd7, 0xe1, 0xf5, 0x33,
+	0x63, 0x73, 0xf3, 0xed, 0xd7, 0xeb, 0x7d, 0xe4, 0x6f, 0xf6, 0x91, 0xff, 0x7b, 0x1f, 0xf9, 0xdf,
+	0x0e, 0x91, 0xb7, 0x39, 0x44, 0xde, 0xcf, 0x43, 0xe4, 0x7d, 0x8c, 0x29, 0xd3, 0xd9, 0x6c, 0x04,
+	0x13, 0x91, 0x23, 0x13, 0x44, 0xcc, 0x89, 0xfe, 0x2c, 0xe4, 0xd8, 0x56, 0xe8, 0xcb, 0xf1, 0x31,
+	0xea, 0xc5, 0x94, 0xa8, 0x51, 0xc9, 0x3c, 0x9a, 0xe7, 0x7f, 0x07, 0x00, 0x30, 0xca, 0xb7, 0xe4,
+	0xa9, 0x02, 0x00, 0x00,
+}
+
+func (m *MinterQuota) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterQuota) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterQuota) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.PeriodLimit.Size()
+		i -= size
+		if _, err := m.PeriodLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size := m.Share.Size()
+		i -= size
+		if _, err := m.Share.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *Minter) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Minter) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Minter) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Quota.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintMinter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMinter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *MinterUsage) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *MinterUsage) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *MinterUsage) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintMinter(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HalvingPeriod != 0 {
+		i = encodeVarintMinter(dAtA, i, uint64(m.HalvingPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintMinter(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintMinter(dAtA []byte, offset int, v uint64) int {
+	offset -= sovMinter(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *MinterQuota) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Share.Size()
+	n += 1 + l + sovMinter(uint64(l))
+	l = m.PeriodLimit.Size()
+	n += 1 + l + sovMinter(uint64(l))
+	return n
+}
+
+func (m *Minter) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	l = m.Quota.Size()
+	n += 1 + l + sovMinter(uint64(l))
+	return n
+}
+
+func (m *MinterUsage) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovMinter(uint64(l))
+	}
+	if m.HalvingPeriod != 0 {
+		n += 1 + sovMinter(uint64(m.HalvingPeriod))
+	}
+	l = m.Amount.Size()
+	n += 1 + l + sovMinter(uint64(l))
+	return n
+}
+
+func sovMinter(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozMinter(x uint64) (n int) {
+	return sovMinter(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *MinterQuota) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterQuota: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterQuota: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Share", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Share.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Minter) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Minter: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Minter: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *MinterUsage) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: MinterUsage: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: MinterUsage: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingPeriod", wireType)
+			}
+			m.HalvingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthMinter
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipMinter(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthMinter
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipMinter(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowMinter
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowMinter
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthMinter
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupMinter
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthMinter
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthMinter        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowMinter          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupMinter = fmt.Errorf("proto: unexpected end of group")
+)
@@ -4,44 +4,52 @@ import (
 	"fmt"
 	"time"
 
+	"cosmossdk.io/math"
 	sdk "github.com/cosmos/cosmos-sdk/types"
 )
 
-// DefaultMintingAddress and DefaultReceivingAddress are intentionally empty.
-// These are deployment-specific values that must be set by the chain operator
-// in the genesis file or via governance before minting operations can proceed.
-const DefaultMintingAddress string = ""
+// DefaultReceivingAddress is intentionally empty. It is a deployment-specific
+// value that must be set by the chain operator in the genesis file or via
+// governance before minting operations can proceed.
 const DefaultReceivingAddress string = ""
 const DefaultDenom string = "uGNOD"
-const DefaultMaxSupply uint64 = 35_000_000_000_000_000 // 35 billion GNOD (in uGNOD)
 const DefaultDistributionStartDate string = "2025-07-22"
 const DefaultMonthsInHalvingPeriod uint64 = 12
 
+// MaxMintReferenceLength is the maximum length in bytes of the free-form
+// reference of a mint.
+const MaxMintReferenceLength = 256
+
+// DefaultMaxSupply is 35 billion GNOD (in uGNOD).
+var DefaultMaxSupply = math.NewInt(35_000_000_000_000_000)
+
 // NewParams creates a new Params instance.
 func NewParams(
-	minting_address string,
 	receiving_address string,
 	denom string,
-	max_supply uint64,
+	max_supply math.Int,
 	distribution_start_date string,
 	months_in_halving_period uint64) Params {
 	return Params{
-		MintingAddress:        minting_address,
 		ReceivingAddress:      receiving_address,
 		Denom:                 denom,
 		MaxSupply:             max_supply,
 		DistributionStartDate: distribution_start_date,
 		MonthsInHalvingPeriod: months_in_halving_period,
+		MaxUnlockJump:         math.ZeroInt(),
+		EmissionCurve:         DefaultEmissionCurve(),
+		MaxMintAmount:         math.ZeroInt(),
+		MaxWindowAmount:       math.ZeroInt(),
 	}
 }
 
 func DefaultParams() Params {
-	return NewParams(DefaultMintingAddress, DefaultReceivingAddress, DefaultDenom, DefaultMaxSupply, DefaultDistributionStartDate, DefaultMonthsInHalvingPeriod)
+	return NewParams(DefaultReceivingAddress, DefaultDenom, DefaultMaxSupply, DefaultDistributionStartDate, DefaultMonthsInHalvingPeriod)
 }
 
 // Validate validates the set of params.
 func (p Params) Validate() error {
-	if err := validateMintingAddress(p.MintingAddress); err != nil {
+	if err := validateMintingAddress(p.MintingAddress); err != nil { //nolint:staticcheck // only checked to be unset
 		return err
 	}
 	if err := validateReceivingAddress(p.ReceivingAddress); err != nil {
@@ -50,6 +58,9 @@ func (p Params) Validate() error {
 	if err := validateDenom(p.Denom); err != nil {
 		return err
 	}
+	if err := validateLegacyMaxSupply(p.LegacyMaxSupply); err != nil { //nolint:staticcheck // only checked to be unset
+		return err
+	}
 	if err := validateMaxSupply(p.MaxSupply); err != nil {
 		return err
 	}
@@ -59,16 +70,42 @@ func (p Params) Validate() error {
 	if err := validateMonthsInHalvingPeriod(p.MonthsInHalvingPeriod); err != nil {
 		return err
 	}
+	if err := validateRecipients(p.Recipients); err != nil {
+		return err
+	}
+	if err := validateAutoMint(p.AutoMint, p.AutoMintEpochIdentifier); err != nil {
+		return err
+	}
+	if err := validateSupplyBasis(p.MaxSupplyBasis); err != nil {
+		return err
+	}
+	if err := validateMaxUnlockJump(p.MaxUnlockJump); err != nil {
+		return err
+	}
+	if err := p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate); err != nil {
+		return err
+	}
+	if err := validateSchedulePrecision(p.SchedulePrecision); err != nil {
+		return err
+	}
+	if err := validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval); err != nil {
+		return err
+	}
+	if err := validateGuardian(p.Guardian); err != nil {
+		return err
+	}
+	if err := validateMintApprovals(p.MintApprovers, p.MintApprovalThreshold, p.MintProposalTtl); err != nil {
+		return err
+	}
+	if err := validateMintDestinations(p.MintDestinations); err != nil {
+		return err
+	}
 
 	return nil
 }
 func validateMintingAddress(v string) error {
-	if v == "" {
-		return fmt.Errorf("minting address cannot be empty")
-	}
-	_, err := sdk.AccAddressFromBech32(v)
-	if err != nil {
-		return fmt.Errorf("invalid minting address: %w", err)
+	if v != "" {
+		return fmt.Errorf("minting address is deprecated: minters are managed through the minter registry")
 	}
 	return nil
 }
@@ -88,8 +125,14 @@ func validateDenom(v string) error {
 	}
 	return nil
 }
-func validateMaxSupply(v uint64) error {
-	if v == 0 {
+func validateLegacyMaxSupply(v uint64) error {
+	if v != 0 {
+		return fmt.Errorf("legacy max supply is deprecated: use max_supply")
+	}
+	return nil
+}
+func validateMaxSupply(v math.Int) error {
+	if v.IsNil() || !v.IsPositive() {
 		return fmt.Errorf("max supply must be greater than zero")
 	}
 	return nil
@@ -99,15 +142,149 @@ func validateDistributionStartDate(v string) error {
 		return fmt.Errorf("distribution start date cannot be empty")
 	}
 
-	_, err := time.Parse("2006-01-02", v)
+	_, err := ParseScheduleTime(v)
 	if err != nil {
-		return fmt.Errorf("distribution start date must be in YYYY-MM-DD format: %w", err)
+		return fmt.Errorf("distribution start date must be in YYYY-MM-DD or RFC3339 format: %w", err)
 	}
 	return nil
 }
+
+// ParseScheduleTime parses a point in time of the distribution schedule given
+// either as a YYYY-MM-DD date, which starts at midnight UTC, or as an RFC3339
+// timestamp. The result is in UTC.
+func ParseScheduleTime(v string) (time.Time, error) {
+	if t, err := time.Parse("2006-01-02", v); err == nil {
+		return t, nil
+	}
+	t, err := time.Parse(time.RFC3339, v)
+	if err != nil {
+		return time.Time{}, err
+	}
+	return t.UTC(), nil
+}
 func validateMonthsInHalvingPeriod(v uint64) error {
 	if v == 0 {
 		return fmt.Errorf("months in halving period must be greater than zero")
 	}
 	return nil
 }
+func validateRecipients(v []Recipient) error {
+	if len(v) == 0 {
+		return nil
+	}
+
+	total := math.LegacyZeroDec()
+	seen := make(map[string]struct{}, len(v))
+	for _, r := range v {
+		if err := r.Validate(); err != nil {
+			return err
+		}
+		key := r.Address
+		if r.Module != "" {
+			key = "module:" + r.Module
+		}
+		if _, ok := seen[key]; ok {
+			return fmt.Errorf("duplicate recipient %s", key)
+		}
+		seen[key] = struct{}{}
+		total = total.Add(r.Weight)
+	}
+
+	if !total.Equal(math.LegacyOneDec()) {
+		return fmt.Errorf("recipient weights must sum to 1, got %s", total)
+	}
+	return nil
+}
+func validateAutoMint(mode AutoMintMode, epochIdentifier string) error {
+	if _, ok := AutoMintMode_name[int32(mode)]; !ok {
+		return fmt.Errorf("invalid auto mint mode %d", mode)
+	}
+	if mode == AutoMintMode_AUTO_MINT_MODE_EPOCH && epochIdentifier == "" {
+		return fmt.Errorf("auto mint epoch identifier cannot be empty in epoch mode")
+	}
+	return nil
+}
+func validateMaxUnlockJump(v math.Int) error {
+	if !v.IsNil() && v.IsNegative() {
+		return fmt.Errorf("max unlock jump cannot be negative")
+	}
+	return nil
+}
+
+func validateGuardian(v string) error {
+	if v == "" {
+		return nil
+	}
+	if _, err := sdk.AccAddressFromBech32(v); err != nil {
+		return fmt.Errorf("invalid guardian address: %w", err)
+	}
+	return nil
+}
+
+func validateMintApprovals(approvers []string, threshold uint32, ttl time.Duration) error {
+	seen := make(map[string]struct{}, len(approvers))
+	for _, approver := range approvers {
+		if _, err := sdk.AccAddressFromBech32(approver); err != nil {
+			return fmt.Errorf("invalid mint approver address: %w", err)
+		}
+		if _, ok := seen[approver]; ok {
+			return fmt.Errorf("duplicate mint approver %s", approver)
+		}
+		seen[approver] = struct{}{}
+	}
+	if int(threshold) > len(approvers) {
+		return fmt.Errorf("mint approval threshold %d exceeds the %d mint approvers", threshold, len(approvers))
+	}
+	if ttl < 0 {
+		return fmt.Errorf("mint proposal ttl cannot be negative")
+	}
+	if threshold > 0 && ttl == 0 {
+		return fmt.Errorf("mint proposal ttl must be set when the mint approval threshold is set")
+	}
+	return nil
+}
+
+func validateMintDestinations(destinations []string) error {
+	seen := make(map[string]struct{}, len(destinations))
+	for _, destination := range destinations {
+		if _, err := sdk.AccAddressFromBech32(destination); err != nil {
+			return fmt.Errorf("invalid mint destination address: %w", err)
+		}
+		if _, ok := seen[destination]; ok {
+			return fmt.Errorf("duplicate mint destination %s", destination)
+		}
+		seen[destination] = struct{}{}
+	}
+	return nil
+}
+
+func validateMintRateLimits(maxMintAmount math.Int, window time.Duration, maxWindowAmount math.Int, minInterval time.Duration) error {
+	if !maxMintAmount.IsNil() && maxMintAmount.IsNegative() {
+		return fmt.Errorf("max mint amount cannot be negative")
+	}
+	if window < 0 {
+		return fmt.Errorf("mint window cannot be negative")
+	}
+	if !maxWindowAmount.IsNil() && maxWindowAmount.IsNegative() {
+		return fmt.Errorf("max window amount cannot be negative")
+	}
+	if (window == 0) != (maxWindowAmount.IsNil() || maxWindowAmount.IsZero()) {
+		return fmt.Errorf("mint window and max window amount must be set together")
+	}
+	if minInterval < 0 {
+		return fmt.Errorf("min mint interval cannot be negative")
+	}
+	return nil
+}
+func validateSchedulePrecision(v SchedulePrecision) error {
+	if _, ok := SchedulePrecision_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid schedule precision %d", v)
+	}
+	return nil
+}
+func validateSupplyBasis(v SupplyBasis) error {
+	if _, ok := SupplyBasis_name[int32(v)]; !ok {
+		return fmt.Errorf("invalid max supply basis %d", v)
+	}
+	return nil
+}
@@ -4,19 +4,25 @@
 package types
 
 import (
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/durationpb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -24,14 +30,215 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// SchedulePrecision selects the granularity of the distribution schedule.
+type SchedulePrecision int32
+
+const (
+	// SCHEDULE_PRECISION_DAY unlocks the allowance of a day at once, every 24
+	// hours from the distribution start.
+	SchedulePrecision_SCHEDULE_PRECISION_DAY SchedulePrecision = 0
+	// SCHEDULE_PRECISION_SECOND pro-rates the distributable amount by the
+	// second of block time.
+	SchedulePrecision_SCHEDULE_PRECISION_SECOND SchedulePrecision = 1
+)
+
+var SchedulePrecision_name = map[int32]string{
+	0: "SCHEDULE_PRECISION_DAY",
+	1: "SCHEDULE_PRECISION_SECOND",
+}
+
+var SchedulePrecision_value = map[string]int32{
+	"SCHEDULE_PRECISION_DAY":    0,
+	"SCHEDULE_PRECISION_SECOND": 1,
+}
+
+func (x SchedulePrecision) String() string {
+	return proto.EnumName(SchedulePrecision_name, int32(x))
+}
+
+func (SchedulePrecision) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{0}
+}
+
+// EmissionCurveType selects the shape of the emission curve.
+type EmissionCurveType int32
+
+const (
+	// EMISSION_CURVE_TYPE_HALVING distributes max_supply / 2^n over the n-th
+	// period, pro-rated linearly by day.
+	EmissionCurveType_EMISSION_CURVE_TYPE_HALVING EmissionCurveType = 0
+	// EMISSION_CURVE_TYPE_LINEAR unlocks max_supply linearly by day over
+	// duration_months months.
+	EmissionCurveType_EMISSION_CURVE_TYPE_LINEAR EmissionCurveType = 1
+	// EMISSION_CURVE_TYPE_EXPONENTIAL distributes decay_ratio times the amount
+	// of the previous period over each period, starting with
+	// max_supply * (1 - decay_ratio), pro-rated linearly by day. A ratio of 0.5
+	// matches the halving curve up to rounding.
+	EmissionCurveType_EMISSION_CURVE_TYPE_EXPONENTIAL EmissionCurveType = 2
+	// EMISSION_CURVE_TYPE_PIECEWISE interpolates the cumulative cap linearly by
+	// day between the points of the table, starting from zero at the
+	// distribution start date. The cap stays at the last point afterwards.
+	EmissionCurveType_EMISSION_CURVE_TYPE_PIECEWISE EmissionCurveType = 3
+)
+
+var EmissionCurveType_name = map[int32]string{
+	0: "EMISSION_CURVE_TYPE_HALVING",
+	1: "EMISSION_CURVE_TYPE_LINEAR",
+	2: "EMISSION_CURVE_TYPE_EXPONENTIAL",
+	3: "EMISSION_CURVE_TYPE_PIECEWISE",
+}
+
+var EmissionCurveType_value = map[string]int32{
+	"EMISSION_CURVE_TYPE_HALVING":     0,
+	"EMISSION_CURVE_TYPE_LINEAR":      1,
+	"EMISSION_CURVE_TYPE_EXPONENTIAL": 2,
+	"EMISSION_CURVE_TYPE_PIECEWISE":   3,
+}
+
+func (x EmissionCurveType) String() string {
+	return proto.EnumName(EmissionCurveType_name, int32(x))
+}
+
+func (EmissionCurveType) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{1}
+}
+
+// AutoMintMode selects the trigger of automatic minting.
+type AutoMintMode int32
+
+const (
+	// AUTO_MINT_MODE_DISABLED disables automatic minting; coins are only minted
+	// through MsgMint.
+	AutoMintMode_AUTO_MINT_MODE_DISABLED AutoMintMode = 0
+	// AUTO_MINT_MODE_BLOCK mints at the beginning of every block.
+	AutoMintMode_AUTO_MINT_MODE_BLOCK AutoMintMode = 1
+	// AUTO_MINT_MODE_EPOCH mints at the end of every epoch of
+	// auto_mint_epoch_identifier.
+	AutoMintMode_AUTO_MINT_MODE_EPOCH AutoMintMode = 2
+)
+
+var AutoMintMode_name = map[int32]string{
+	0: "AUTO_MINT_MODE_DISABLED",
+	1: "AUTO_MINT_MODE_BLOCK",
+	2: "AUTO_MINT_MODE_EPOCH",
+}
+
+var AutoMintMode_value = map[string]int32{
+	"AUTO_MINT_MODE_DISABLED": 0,
+	"AUTO_MINT_MODE_BLOCK":    1,
+	"AUTO_MINT_MODE_EPOCH":    2,
+}
+
+func (x AutoMintMode) String() string {
+	return proto.EnumName(AutoMintMode_name, int32(x))
+}
+
+func (AutoMintMode) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{2}
+}
+
+// SupplyBasis selects which supply of denom counts towards max_supply.
+type SupplyBasis int32
+
+const (
+	// SUPPLY_BASIS_BANK checks the total supply reported by x/bank, which also
+	// includes genesis allocations, inflation and tokens minted elsewhere.
+	SupplyBasis_SUPPLY_BASIS_BANK SupplyBasis = 0
+	// SUPPLY_BASIS_DISTRO checks the cumulative amount minted by this module.
+	SupplyBasis_SUPPLY_BASIS_DISTRO SupplyBasis = 1
+)
+
+var SupplyBasis_name = map[int32]string{
+	0: "SUPPLY_BASIS_BANK",
+	1: "SUPPLY_BASIS_DISTRO",
+}
+
+var SupplyBasis_value = map[string]int32{
+	"SUPPLY_BASIS_BANK":   0,
+	"SUPPLY_BASIS_DISTRO": 1,
+}
+
+func (x SupplyBasis) String() string {
+	return proto.EnumName(SupplyBasis_name, int32(x))
+}
+
+func (SupplyBasis) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{3}
+}
+
 // Params defines the parameters for the module.
 type Params struct {
-	MintingAddress        string `protobuf:"bytes,1,opt,name=minting_address,json=mintingAddress,proto3" json:"minting_address,omitempty"`
-	ReceivingAddress      string `protobuf:"bytes,2,opt,name=receiving_address,json=receivingAddress,proto3" json:"receiving_address,omitempty"`
-	Denom                 string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
-	MaxSupply             uint64 `protobuf:"varint,4,opt,name=max_supply,json=maxSupply,proto3" json:"max_supply,omitempty"`
+	// minting_address is deprecated: authorized minters are kept in the minter
+	// registry. It is only read by the v1 to v2 store migration.
+	MintingAddress string `protobuf:"bytes,1,opt,name=minting_address,json=mintingAddress,proto3" json:"minting_address,omitempty"` // Deprecated: Do not use.
+	// receiving_address receives the whole mint when no recipients are
+	// configured, and the rounding dust of the weighted split otherwise.
+	ReceivingAddress string `protobuf:"bytes,2,opt,name=receiving_address,json=receivingAddress,proto3" json:"receiving_address,omitempty"`
+	Denom            string `protobuf:"bytes,3,opt,name=denom,proto3" json:"denom,omitempty"`
+	// legacy_max_supply is deprecated in favour of max_supply. It is only read
+	// by the v2 to v3 store migration.
+	LegacyMaxSupply uint64 `protobuf:"varint,4,opt,name=legacy_max_supply,json=legacyMaxSupply,proto3" json:"legacy_max_supply,omitempty"` // Deprecated: Do not use.
+	// distribution_start_date is the start of the distribution, either as a
+	// YYYY-MM-DD date starting at midnight UTC or as an RFC3339 timestamp.
 	DistributionStartDate string `protobuf:"bytes,5,opt,name=distribution_start_date,json=distributionStartDate,proto3" json:"distribution_start_date,omitempty"`
 	MonthsInHalvingPeriod uint64 `protobuf:"varint,6,opt,name=months_in_halving_period,json=monthsInHalvingPeriod,proto3" json:"months_in_halving_period,omitempty"`
+	// recipients split every mint by weight. Their weights must sum to one.
+	Recipients []Recipient `protobuf:"bytes,7,rep,name=recipients,proto3" json:"recipients"`
+	// auto_mint selects whether and when the module mints the newly unlocked
+	// distributable amount by itself.
+	AutoMint AutoMintMode `protobuf:"varint,8,opt,name=auto_mint,json=autoMint,proto3,enum=gnodi.distro.v1.AutoMintMode" json:"auto_mint,omitempty"`
+	// auto_mint_epoch_identifier is the x/epochs identifier whose epoch end
+	// triggers automatic minting when auto_mint is AUTO_MINT_MODE_EPOCH.
+	AutoMintEpochIdentifier string `protobuf:"bytes,9,opt,name=auto_mint_epoch_identifier,json=autoMintEpochIdentifier,proto3" json:"auto_mint_epoch_identifier,omitempty"`
+	// max_supply is the cap on the supply of denom measured by
+	// max_supply_basis.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// max_supply_basis selects the supply that max_supply is checked against.
+	// The distribution schedule always caps the amount minted by this module.
+	MaxSupplyBasis SupplyBasis `protobuf:"varint,11,opt,name=max_supply_basis,json=maxSupplyBasis,proto3,enum=gnodi.distro.v1.SupplyBasis" json:"max_supply_basis,omitempty"`
+	// burns_reopen_max_supply, if set, subtracts the amount burned through the
+	// module from the supply checked against max_supply, so burned coins can be
+	// minted again within max_supply. The distribution schedule always caps the
+	// cumulative amount minted by the module, so burns never reopen it.
+	BurnsReopenMaxSupply bool `protobuf:"varint,12,opt,name=burns_reopen_max_supply,json=burnsReopenMaxSupply,proto3" json:"burns_reopen_max_supply,omitempty"`
+	// max_unlock_jump caps the increase of the amount distributable at the
+	// current block time that a params change may cause, unless the change
+	// overrides the schedule guard. Zero disables the cap.
+	MaxUnlockJump cosmossdk_io_math.Int `protobuf:"bytes,13,opt,name=max_unlock_jump,json=maxUnlockJump,proto3,customtype=cosmossdk.io/math.Int" json:"max_unlock_jump"`
+	// emission_curve selects how max_supply unlocks over time, starting at
+	// distribution_start_date. Periods of months_in_halving_period months
+	// remain the accounting periods of minter quotas whatever the curve.
+	EmissionCurve EmissionCurve `protobuf:"bytes,14,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve"`
+	// schedule_precision selects the granularity at which the distributable
+	// amount unlocks.
+	SchedulePrecision SchedulePrecision `protobuf:"varint,15,opt,name=schedule_precision,json=schedulePrecision,proto3,enum=gnodi.distro.v1.SchedulePrecision" json:"schedule_precision,omitempty"`
+	// max_mint_amount caps the amount of a single MsgMint. Zero disables the
+	// cap.
+	MaxMintAmount cosmossdk_io_math.Int `protobuf:"bytes,16,opt,name=max_mint_amount,json=maxMintAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_mint_amount"`
+	// mint_window is the length of the rolling window over which
+	// max_window_amount applies. Zero disables the window limit.
+	MintWindow time.Duration `protobuf:"bytes,17,opt,name=mint_window,json=mintWindow,proto3,stdduration" json:"mint_window"`
+	// max_window_amount caps the total amount of the MsgMint included in the
+	// last mint_window. Zero disables the window limit.
+	MaxWindowAmount cosmossdk_io_math.Int `protobuf:"bytes,18,opt,name=max_window_amount,json=maxWindowAmount,proto3,customtype=cosmossdk.io/math.Int" json:"max_window_amount"`
+	// min_mint_interval is the minimum time between two MsgMint. Zero disables
+	// the interval check.
+	MinMintInterval time.Duration `protobuf:"bytes,19,opt,name=min_mint_interval,json=minMintInterval,proto3,stdduration" json:"min_mint_interval"`
+	// guardian may pause minting in an emergency, but only the authority may
+	// unpause it. Empty means no guardian.
+	Guardian string `protobuf:"bytes,20,opt,name=guardian,proto3" json:"guardian,omitempty"`
+	// mint_approvers may approve mint proposals.
+	MintApprovers []string `protobuf:"bytes,21,rep,name=mint_approvers,json=mintApprovers,proto3" json:"mint_approvers,omitempty"`
+	// mint_approval_threshold is the number of mint_approvers that must approve
+	// a mint proposal before it is executed. While it is set, MsgMint is
+	// rejected and mints go through MsgProposeMint. Zero disables the approval
+	// flow.
+	MintApprovalThreshold uint32 `protobuf:"varint,22,opt,name=mint_approval_threshold,json=mintApprovalThreshold,proto3" json:"mint_approval_threshold,omitempty"`
+	// mint_proposal_ttl is how long a mint proposal may collect approvals.
+	MintProposalTtl time.Duration `protobuf:"bytes,23,opt,name=mint_proposal_ttl,json=mintProposalTtl,proto3,stdduration" json:"mint_proposal_ttl"`
+	// mint_destinations is the allowlist of accounts a mint may be sent to
+	// instead of receiving_address and the weighted recipients.
+	MintDestinations []string `protobuf:"bytes,24,rep,name=mint_destinations,json=mintDestinations,proto3" json:"mint_destinations,omitempty"`
 }
 
 func (m *Params) Reset()         { *m = Params{} }
@@ -67,6 +274,7 @@ func (m *Params) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_Params proto.InternalMessageInfo
 
+// Deprecated: Do not use.
 func (m *Params) GetMintingAddress() string {
 	if m != nil {
 		return m.MintingAddress
@@ -88,9 +296,10 @@ func (m *Params) GetDenom() string {
 	return ""
 }
 
-func (m *Params) GetMaxSupply() uint64 {
+// Deprecated: Do not use.
+func (m *Params) GetLegacyMaxSupply() uint64 {
 	if m != nil {
-		return m.MaxSupply
+		return m.LegacyMaxSupply
 	}
 	return 0
 }
@@ -109,36 +318,379 @@ func (m *Params) GetMonthsInHalvingPeriod() uint64 {
 	return 0
 }
 
+func (m *Params) GetRecipients() []Recipient {
+	if m != nil {
+		return m.Recipients
+	}
+	return nil
+}
+
+func (m *Params) GetAutoMint() AutoMintMode {
+	if m != nil {
+		return m.AutoMint
+	}
+	return AutoMintMode_AUTO_MINT_MODE_DISABLED
+}
+
+func (m *Params) GetAutoMintEpochIdentifier() string {
+	if m != nil {
+		return m.AutoMintEpochIdentifier
+	}
+	return ""
+}
+
+func (m *Params) GetMaxSupplyBasis() SupplyBasis {
+	if m != nil {
+		return m.MaxSupplyBasis
+	}
+	return SupplyBasis_SUPPLY_BASIS_BANK
+}
+
+func (m *Params) GetBurnsReopenMaxSupply() bool {
+	if m != nil {
+		return m.BurnsReopenMaxSupply
+	}
+	return false
+}
+
+func (m *Params) GetEmissionCurve() EmissionCurve {
+	if m != nil {
+		return m.EmissionCurve
+	}
+	return EmissionCurve{}
+}
+
+func (m *Params) GetSchedulePrecision() SchedulePrecision {
+	if m != nil {
+		return m.SchedulePrecision
+	}
+	return SchedulePrecision_SCHEDULE_PRECISION_DAY
+}
+
+func (m *Params) GetMintWindow() time.Duration {
+	if m != nil {
+		return m.MintWindow
+	}
+	return 0
+}
+
+func (m *Params) GetMinMintInterval() time.Duration {
+	if m != nil {
+		return m.MinMintInterval
+	}
+	return 0
+}
+
+func (m *Params) GetGuardian() string {
+	if m != nil {
+		return m.Guardian
+	}
+	return ""
+}
+
+func (m *Params) GetMintApprovers() []string {
+	if m != nil {
+		return m.MintApprovers
+	}
+	return nil
+}
+
+func (m *Params) GetMintApprovalThreshold() uint32 {
+	if m != nil {
+		return m.MintApprovalThreshold
+	}
+	return 0
+}
+
+func (m *Params) GetMintProposalTtl() time.Duration {
+	if m != nil {
+		return m.MintProposalTtl
+	}
+	return 0
+}
+
+func (m *Params) GetMintDestinations() []string {
+	if m != nil {
+		return m.MintDestinations
+	}
+	return nil
+}
+
+// EmissionCurve is the emission curve selected in params. Only the fields
+// used by its type may be set.
+type EmissionCurve struct {
+	Type EmissionCurveType `protobuf:"varint,1,opt,name=type,proto3,enum=gnodi.distro.v1.EmissionCurveType" json:"type,omitempty"`
+	// duration_months is the length of the linear curve.
+	DurationMonths uint64 `protobuf:"varint,2,opt,name=duration_months,json=durationMonths,proto3" json:"duration_months,omitempty"`
+	// decay_ratio is the ratio between the amounts of two consecutive periods
+	// of the exponential curve, strictly between zero and one.
+	DecayRatio cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=decay_ratio,json=decayRatio,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"decay_ratio"`
+	// points is the table of the piecewise curve, ordered by date.
+	Points []EmissionPoint `protobuf:"bytes,4,rep,name=points,proto3" json:"points"`
+}
+
+func (m *EmissionCurve) Reset()         { *m = EmissionCurve{} }
+func (m *EmissionCurve) String() string { return proto.CompactTextString(m) }
+func (*EmissionCurve) ProtoMessage()    {}
+func (*EmissionCurve) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{1}
+}
+func (m *EmissionCurve) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionCurve) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionCurve.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionCurve) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionCurve.Merge(m, src)
+}
+func (m *EmissionCurve) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionCurve) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionCurve.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionCurve proto.InternalMessageInfo
+
+func (m *EmissionCurve) GetType() EmissionCurveType {
+	if m != nil {
+		return m.Type
+	}
+	return EmissionCurveType_EMISSION_CURVE_TYPE_HALVING
+}
+
+func (m *EmissionCurve) GetDurationMonths() uint64 {
+	if m != nil {
+		return m.DurationMonths
+	}
+	return 0
+}
+
+func (m *EmissionCurve) GetPoints() []EmissionPoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// EmissionPoint is a point of a piecewise emission curve.
+type EmissionPoint struct {
+	// date is the day the cumulative cap is reached, either as a YYYY-MM-DD
+	// date starting at midnight UTC or as an RFC3339 timestamp.
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// cumulative_cap is the cumulative distributable cap at date.
+	CumulativeCap cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=cumulative_cap,json=cumulativeCap,proto3,customtype=cosmossdk.io/math.Int" json:"cumulative_cap"`
+}
+
+func (m *EmissionPoint) Reset()         { *m = EmissionPoint{} }
+func (m *EmissionPoint) String() string { return proto.CompactTextString(m) }
+func (*EmissionPoint) ProtoMessage()    {}
+func (*EmissionPoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{2}
+}
+func (m *EmissionPoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *EmissionPoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_EmissionPoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *EmissionPoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EmissionPoint.Merge(m, src)
+}
+func (m *EmissionPoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *EmissionPoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_EmissionPoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EmissionPoint proto.InternalMessageInfo
+
+func (m *EmissionPoint) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+// Recipient is a weighted destination for minted coins. Exactly one of address
+// and module must be set.
+type Recipient struct {
+	// address is the account address of the recipient.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+	// module is the name of a module account recipient. Coins sent to the
+	// x/distribution module account are deposited into the community pool. The
+	// x/distro module account and the other accounts blocked by x/bank cannot
+	// be recipients.
+	Module string `protobuf:"bytes,2,opt,name=module,proto3" json:"module,omitempty"`
+	// weight is the fraction of every mint sent to the recipient.
+	Weight cosmossdk_io_math.LegacyDec `protobuf:"bytes,3,opt,name=weight,proto3,customtype=cosmossdk.io/math.LegacyDec" json:"weight"`
+}
+
+func (m *Recipient) Reset()         { *m = Recipient{} }
+func (m *Recipient) String() string { return proto.CompactTextString(m) }
+func (*Recipient) ProtoMessage()    {}
+func (*Recipient) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a36e9d1654627f0b, []int{3}
+}
+func (m *Recipient) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *Recipient) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_Recipient.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *Recipient) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Recipient.Merge(m, src)
+}
+func (m *Recipient) XXX_Size() int {
+	return m.Size()
+}
+func (m *Recipient) XXX_DiscardUnknown() {
+	xxx_messageInfo_Recipient.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Recipient proto.InternalMessageInfo
+
+func (m *Recipient) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+func (m *Recipient) GetModule() string {
+	if m != nil {
+		return m.Module
+	}
+	return ""
+}
+
 func init() {
+	proto.RegisterEnum("gnodi.distro.v1.SchedulePrecision", SchedulePrecision_name, SchedulePrecision_value)
+	proto.RegisterEnum("gnodi.distro.v1.EmissionCurveType", EmissionCurveType_name, EmissionCurveType_value)
+	proto.RegisterEnum("gnodi.distro.v1.AutoMintMode", AutoMintMode_name, AutoMintMode_value)
+	proto.RegisterEnum("gnodi.distro.v1.SupplyBasis", SupplyBasis_name, SupplyBasis_value)
 	proto.RegisterType((*Params)(nil), "gnodi.distro.v1.Params")
+	proto.RegisterType((*EmissionCurve)(nil), "gnodi.distro.v1.EmissionCurve")
+	proto.RegisterType((*EmissionPoint)(nil), "gnodi.distro.v1.EmissionPoint")
+	proto.RegisterType((*Recipient)(nil), "gnodi.distro.v1.Recipient")
 }
 
 func init() { proto.RegisterFile("gnodi/distro/v1/params.proto", fileDescriptor_a36e9d1654627f0b) }
 
 var fileDescriptor_a36e9d1654627f0b = []byte{
-	// 344 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x5c, 0x90, 0xc1, 0x4e, 0x2a, 0x31,
-	0x18, 0x85, 0x19, 0x2e, 0x90, 0xd0, 0xc5, 0xe5, 0x32, 0x81, 0xdc, 0x09, 0xd1, 0x4a, 0xdc, 0x48,
-	0x34, 0x4c, 0x43, 0x4c, 0x34, 0x71, 0xa7, 0x31, 0x51, 0x77, 0x04, 0x76, 0x6e, 0x26, 0x85, 0x36,
-	0x43, 0x23, 0x6d, 0x27, 0x6d, 0x41, 0x78, 0x05, 0x57, 0x3e, 0x82, 0x0b, 0x1f, 0xc0, 0xc7, 0x70,
-	0xc9, 0xd2, 0xa5, 0x81, 0x85, 0x3e, 0x86, 0xe1, 0xaf, 0x18, 0xe2, 0xa6, 0xf9, 0x7b, 0xbe, 0xd3,
-	0xd3, 0xf6, 0xa0, 0x9d, 0x54, 0x69, 0x26, 0x08, 0x13, 0xd6, 0x19, 0x4d, 0xa6, 0x1d, 0x92, 0x51,
-	0x43, 0xa5, 0x8d, 0x33, 0xa3, 0x9d, 0x0e, 0x2b, 0x40, 0x63, 0x4f, 0xe3, 0x69, 0xa7, 0x51, 0xa5,
-	0x52, 0x28, 0x4d, 0x60, 0xf5, 0x9e, 0x46, 0x2d, 0xd5, 0xa9, 0x86, 0x91, 0xac, 0x27, 0xaf, 0xee,
-	0x3f, 0xe7, 0x51, 0xa9, 0x0b, 0x51, 0xe1, 0x01, 0xaa, 0x48, 0xa1, 0x9c, 0x50, 0x69, 0x42, 0x19,
-	0x33, 0xdc, 0xda, 0x28, 0x68, 0x06, 0xad, 0x72, 0xef, 0xef, 0xb7, 0x7c, 0xee, 0xd5, 0xf0, 0x08,
-	0x55, 0x0d, 0x1f, 0x72, 0x31, 0xdd, 0xb6, 0xe6, 0xc1, 0xfa, 0xef, 0x07, 0x6c, 0xcc, 0x35, 0x54,
-	0x64, 0x5c, 0x69, 0x19, 0xfd, 0x01, 0x83, 0xdf, 0x84, 0xbb, 0x08, 0x49, 0x3a, 0x4b, 0xec, 0x24,
-	0xcb, 0xc6, 0xf3, 0xa8, 0xd0, 0x0c, 0x5a, 0x85, 0x5e, 0x59, 0xd2, 0x59, 0x1f, 0x84, 0xf0, 0x04,
-	0xfd, 0x87, 0xbf, 0x88, 0xc1, 0xc4, 0x09, 0xad, 0x12, 0xeb, 0xa8, 0x71, 0x09, 0xa3, 0x8e, 0x47,
-	0x45, 0x88, 0xa9, 0x6f, 0xe3, 0xfe, 0x9a, 0x5e, 0x52, 0xc7, 0xc3, 0x53, 0x14, 0x49, 0xad, 0xdc,
-	0xc8, 0x26, 0x42, 0x25, 0x23, 0x3a, 0x86, 0x17, 0x66, 0xdc, 0x08, 0xcd, 0xa2, 0x12, 0x5c, 0x52,
-	0xf7, 0xfc, 0x46, 0x5d, 0x7b, 0xda, 0x05, 0x78, 0x86, 0x3f, 0x9f, 0xf6, 0x82, 0x87, 0x8f, 0x97,
-	0xc3, 0xba, 0xef, 0x79, 0xb6, 0x69, 0xda, 0x77, 0x73, 0x71, 0xf5, 0xba, 0xc4, 0xc1, 0x62, 0x89,
-	0x83, 0xf7, 0x25, 0x0e, 0x1e, 0x57, 0x38, 0xb7, 0x58, 0xe1, 0xdc, 0xdb, 0x0a, 0xe7, 0x6e, 0xdb,
-	0xa9, 0x70, 0xa3, 0xc9, 0x20, 0x1e, 0x6a, 0x49, 0xe0, 0x6c, 0x5b, 0x71, 0x77, 0xaf, 0xcd, 0x1d,
-	0xf9, 0x95, 0xe4, 0xe6, 0x19, 0xb7, 0x83, 0x12, 0xd4, 0x7e, 0xfc, 0x15, 0x00, 0x00, 0xff, 0xff,
-	0x4b, 0x5b, 0xa1, 0x89, 0xd0, 0x01, 0x00, 0x00,
+	// 1319 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4d, 0x6f, 0xdb, 0x46,
+	0x13, 0x16, 0x6d, 0xc5, 0xb1, 0xd7, 0x91, 0x4c, 0x6d, 0xac, 0x98, 0x71, 0x12, 0x59, 0xaf, 0xdf,
+	0x43, 0x05, 0x07, 0x96, 0x1a, 0xb7, 0x4d, 0x81, 0x14, 0x45, 0xa1, 0x0f, 0x36, 0x66, 0xa3, 0xaf,
+	0x52, 0x72, 0x9c, 0x14, 0x05, 0x16, 0x6b, 0x72, 0x23, 0x6d, 0x23, 0x72, 0x09, 0x72, 0x29, 0xdb,
+	0xd7, 0x1e, 0x7b, 0x2a, 0x7a, 0xea, 0xa1, 0x87, 0x1e, 0x7b, 0x29, 0x90, 0x43, 0x7e, 0x44, 0x8e,
+	0x41, 0x4e, 0x45, 0x0f, 0x69, 0x11, 0x1f, 0xd2, 0x4b, 0xff, 0x43, 0xb1, 0x4b, 0x4a, 0x96, 0x2d,
+	0x27, 0x45, 0x9d, 0x8b, 0xa0, 0xd9, 0xe7, 0x99, 0x87, 0x33, 0xb3, 0xc3, 0x19, 0x82, 0xeb, 0x3d,
+	0x97, 0xd9, 0xb4, 0x64, 0xd3, 0x80, 0xfb, 0xac, 0x34, 0xbc, 0x55, 0xf2, 0xb0, 0x8f, 0x9d, 0xa0,
+	0xe8, 0xf9, 0x8c, 0x33, 0xb8, 0x24, 0xd1, 0x62, 0x84, 0x16, 0x87, 0xb7, 0x56, 0x33, 0xd8, 0xa1,
+	0x2e, 0x2b, 0xc9, 0xdf, 0x88, 0xb3, 0x7a, 0xd5, 0x62, 0x81, 0xc3, 0x02, 0x24, 0xad, 0x52, 0x64,
+	0xc4, 0xd0, 0x72, 0x8f, 0xf5, 0x58, 0x74, 0x2e, 0xfe, 0xc5, 0xa7, 0xb9, 0x1e, 0x63, 0xbd, 0x01,
+	0x29, 0x49, 0x6b, 0x2f, 0x7c, 0x54, 0xb2, 0x43, 0x1f, 0x73, 0xca, 0xdc, 0x08, 0x5f, 0xff, 0xfb,
+	0x12, 0x98, 0x6b, 0xcb, 0x28, 0xe0, 0x4d, 0xb0, 0xe4, 0x50, 0x97, 0x53, 0xb7, 0x87, 0xb0, 0x6d,
+	0xfb, 0x24, 0x08, 0x34, 0x25, 0xaf, 0x14, 0x16, 0x2a, 0x33, 0x9a, 0x62, 0xa6, 0x63, 0xa8, 0x1c,
+	0x21, 0xf0, 0x26, 0xc8, 0xf8, 0xc4, 0x22, 0x74, 0x38, 0x49, 0x9f, 0x11, 0x74, 0x53, 0x1d, 0x03,
+	0x23, 0xf2, 0x32, 0xb8, 0x60, 0x13, 0x97, 0x39, 0xda, 0xac, 0x24, 0x44, 0x06, 0x2c, 0x82, 0xcc,
+	0x80, 0xf4, 0xb0, 0x75, 0x88, 0x1c, 0x7c, 0x80, 0x82, 0xd0, 0xf3, 0x06, 0x87, 0x5a, 0x32, 0xaf,
+	0x14, 0x92, 0xf2, 0x89, 0x4b, 0x11, 0xd8, 0xc0, 0x07, 0x1d, 0x09, 0xc1, 0xdb, 0x60, 0x45, 0xd6,
+	0x86, 0xee, 0x85, 0x22, 0x01, 0x14, 0x70, 0xec, 0x73, 0x64, 0x63, 0x4e, 0xb4, 0x0b, 0x52, 0x37,
+	0x3b, 0x09, 0x77, 0x04, 0x5a, 0xc3, 0x9c, 0xc0, 0x8f, 0x81, 0xe6, 0x30, 0x97, 0xf7, 0x03, 0x44,
+	0x5d, 0xd4, 0xc7, 0x03, 0x19, 0xb2, 0x47, 0x7c, 0xca, 0x6c, 0x6d, 0x4e, 0x3c, 0xce, 0xcc, 0x46,
+	0xb8, 0xe1, 0x6e, 0x47, 0x68, 0x5b, 0x82, 0x50, 0x07, 0xc0, 0x27, 0x16, 0xf5, 0x28, 0x71, 0x79,
+	0xa0, 0x5d, 0xcc, 0xcf, 0x16, 0x16, 0xb7, 0x56, 0x8b, 0xa7, 0x6e, 0xa9, 0x68, 0x8e, 0x28, 0x95,
+	0x85, 0x67, 0x2f, 0xd7, 0x12, 0xbf, 0xbc, 0x7e, 0xb2, 0xa1, 0x98, 0x13, 0x8e, 0xf0, 0x0e, 0x58,
+	0xc0, 0x21, 0x67, 0x48, 0x54, 0x50, 0x9b, 0xcf, 0x2b, 0x85, 0xf4, 0xd6, 0x8d, 0x29, 0x95, 0x72,
+	0xc8, 0x59, 0x83, 0xba, 0xbc, 0xc1, 0x6c, 0x62, 0xce, 0xe3, 0xd8, 0x82, 0x9f, 0x80, 0xd5, 0xb1,
+	0x2f, 0x22, 0x1e, 0xb3, 0xfa, 0x88, 0xda, 0xc4, 0xe5, 0xf4, 0x11, 0x25, 0xbe, 0xb6, 0x20, 0xd3,
+	0x5e, 0x19, 0xb1, 0x75, 0x81, 0x1b, 0x63, 0x18, 0xb6, 0x00, 0x98, 0xa8, 0x2c, 0x90, 0x77, 0xf9,
+	0xbe, 0x88, 0xf1, 0xf7, 0x97, 0x6b, 0xd9, 0xa8, 0x77, 0x02, 0xfb, 0x71, 0x91, 0xb2, 0x92, 0x83,
+	0x79, 0xbf, 0x68, 0xb8, 0xfc, 0xc5, 0xd3, 0x4d, 0x10, 0x01, 0xc2, 0x8a, 0x52, 0x59, 0x70, 0xc6,
+	0x37, 0xf0, 0x39, 0x50, 0x8f, 0x05, 0xd1, 0x1e, 0x0e, 0x68, 0xa0, 0x2d, 0xca, 0x84, 0xae, 0x4f,
+	0x25, 0x14, 0xb9, 0x54, 0x04, 0xc7, 0x4c, 0x8f, 0x25, 0xa4, 0x0d, 0x3f, 0x02, 0x2b, 0x7b, 0xa1,
+	0xef, 0x06, 0xc8, 0x27, 0xcc, 0x23, 0xee, 0xe4, 0xfd, 0x5f, 0xca, 0x2b, 0x85, 0x79, 0x73, 0x59,
+	0xc2, 0xa6, 0x44, 0x8f, 0x1b, 0xe0, 0x01, 0x58, 0x12, 0xcc, 0xd0, 0x1d, 0x30, 0xeb, 0x31, 0xfa,
+	0x26, 0x74, 0x3c, 0x2d, 0x75, 0xce, 0xa4, 0x52, 0x0e, 0x3e, 0xd8, 0x91, 0x3a, 0x5f, 0x84, 0x8e,
+	0x07, 0xdb, 0x20, 0x4d, 0x1c, 0x1a, 0x04, 0xa2, 0xad, 0xac, 0xd0, 0x1f, 0x12, 0x2d, 0x9d, 0x57,
+	0x0a, 0x8b, 0x5b, 0xb9, 0xa9, 0xb4, 0xf4, 0x98, 0x56, 0x15, 0xac, 0xc9, 0x1b, 0x4f, 0x91, 0x49,
+	0x04, 0x7e, 0x09, 0x60, 0x60, 0xf5, 0x89, 0x1d, 0x0e, 0x08, 0xf2, 0x44, 0x33, 0x08, 0x48, 0x5b,
+	0x92, 0xc5, 0x5a, 0x9f, 0x2e, 0x56, 0x4c, 0x6d, 0x8f, 0x98, 0x66, 0x26, 0x38, 0x7d, 0x34, 0x4a,
+	0x5f, 0xb6, 0x02, 0x76, 0x58, 0xe8, 0x72, 0x4d, 0x7d, 0x87, 0xf4, 0x45, 0xc7, 0x94, 0xa5, 0x0c,
+	0x34, 0xc0, 0xa2, 0x54, 0xdd, 0xa7, 0xae, 0xcd, 0xf6, 0xb5, 0x8c, 0xcc, 0xfd, 0x6a, 0x31, 0x1a,
+	0x1d, 0xc5, 0xd1, 0xe8, 0x28, 0xd6, 0xe2, 0xd1, 0x51, 0x49, 0x89, 0x07, 0xfe, 0xf8, 0xc7, 0x9a,
+	0x12, 0x37, 0xbb, 0x70, 0xde, 0x95, 0xbe, 0xf0, 0x6b, 0x90, 0x11, 0x41, 0x46, 0x4a, 0xa3, 0x30,
+	0xe1, 0x39, 0xc3, 0x14, 0xf9, 0x46, 0xba, 0x71, 0xa0, 0x5d, 0x90, 0x71, 0xa8, 0x1b, 0x95, 0x80,
+	0xba, 0x9c, 0xf8, 0x43, 0x3c, 0xd0, 0x2e, 0xff, 0xc7, 0x70, 0xc5, 0x94, 0x13, 0xc9, 0x1b, 0xb1,
+	0x00, 0xfc, 0x10, 0xcc, 0xf7, 0x42, 0xec, 0xdb, 0x14, 0xbb, 0xda, 0xb2, 0x0c, 0x55, 0x7b, 0xf1,
+	0x74, 0x73, 0x39, 0x8e, 0x26, 0x1e, 0x62, 0x1d, 0xee, 0x53, 0xb7, 0x67, 0x8e, 0x99, 0xf0, 0x33,
+	0x90, 0x8e, 0xae, 0xc2, 0xf3, 0x7c, 0x36, 0x24, 0x7e, 0xa0, 0x65, 0xf3, 0xb3, 0x6f, 0xf5, 0x4d,
+	0x09, 0x7e, 0x79, 0x44, 0x17, 0xf3, 0x6c, 0x42, 0x00, 0x0f, 0x10, 0xef, 0xfb, 0x24, 0xe8, 0xb3,
+	0x81, 0xad, 0x5d, 0xc9, 0x2b, 0x85, 0x94, 0x99, 0x3d, 0xe6, 0xe3, 0x41, 0x77, 0x04, 0xc6, 0x45,
+	0xe0, 0x62, 0x07, 0x78, 0x2c, 0x10, 0x7e, 0x7c, 0xa0, 0xad, 0x9c, 0xa3, 0x08, 0xbc, 0x1d, 0x2b,
+	0x74, 0xf9, 0x00, 0xea, 0xb1, 0xaa, 0x4d, 0x02, 0x4e, 0x5d, 0xe9, 0x13, 0x68, 0xda, 0xbf, 0x64,
+	0xa4, 0x0a, 0x97, 0xda, 0x84, 0xc7, 0x9d, 0xdc, 0x5f, 0x3f, 0xaf, 0x29, 0xdf, 0xbd, 0x7e, 0xb2,
+	0x91, 0x8d, 0x76, 0xdd, 0xc1, 0x68, 0xdb, 0x45, 0x4b, 0x66, 0xfd, 0x87, 0x19, 0x90, 0x3a, 0xf1,
+	0x0e, 0xc1, 0xdb, 0x20, 0xc9, 0x0f, 0x3d, 0xa2, 0x29, 0x6f, 0x78, 0x37, 0x4e, 0xb0, 0xbb, 0x87,
+	0x1e, 0x31, 0x25, 0x1f, 0xbe, 0x07, 0x96, 0x46, 0xbb, 0x0c, 0x45, 0xf3, 0x5b, 0xee, 0x9f, 0xa4,
+	0x99, 0x1e, 0x1d, 0x37, 0xe4, 0x29, 0xdc, 0x05, 0x8b, 0x36, 0xb1, 0xf0, 0x21, 0x92, 0xa7, 0xd1,
+	0x0e, 0xaa, 0xdc, 0x8e, 0x9b, 0xf1, 0xda, 0x74, 0x33, 0xd6, 0xe5, 0xde, 0xa9, 0x11, 0x6b, 0xa2,
+	0x25, 0x6b, 0xc4, 0x8a, 0x7b, 0x5d, 0x4a, 0x99, 0x42, 0x09, 0x96, 0xc1, 0x9c, 0xc7, 0xa8, 0xd8,
+	0x0d, 0xc9, 0xfc, 0xec, 0x5b, 0xa7, 0x45, 0x5b, 0xd0, 0x26, 0xa7, 0x45, 0xec, 0x78, 0x27, 0x29,
+	0xca, 0xb5, 0xfe, 0xad, 0x02, 0x52, 0x27, 0xa8, 0x10, 0x82, 0xa4, 0x5c, 0x6c, 0x72, 0x01, 0x9b,
+	0xf2, 0x3f, 0xdc, 0x05, 0x69, 0x2b, 0x74, 0xc2, 0x01, 0xe6, 0x74, 0x48, 0x90, 0x85, 0x3d, 0x6d,
+	0xe6, 0x9c, 0xef, 0x55, 0xea, 0x58, 0xa7, 0x8a, 0xbd, 0x38, 0x88, 0x5f, 0x15, 0xb0, 0x30, 0xde,
+	0x65, 0x70, 0x0b, 0x5c, 0x3c, 0xf9, 0x11, 0xf0, 0xe6, 0x26, 0x18, 0x11, 0xe1, 0x15, 0x30, 0xe7,
+	0x30, 0x31, 0xb3, 0xe2, 0x0f, 0x81, 0xd8, 0x82, 0x4d, 0x30, 0xb7, 0x4f, 0x68, 0xaf, 0xcf, 0xdf,
+	0xb1, 0xf6, 0xb1, 0x4a, 0x14, 0xef, 0x46, 0x13, 0x64, 0xa6, 0xc6, 0x26, 0x5c, 0x05, 0x57, 0x3a,
+	0xd5, 0x6d, 0xbd, 0xb6, 0x53, 0xd7, 0x51, 0xdb, 0xd4, 0xab, 0x46, 0xc7, 0x68, 0x35, 0x51, 0xad,
+	0xfc, 0x50, 0x4d, 0xc0, 0x1b, 0xe0, 0xea, 0x19, 0x58, 0x47, 0xaf, 0xb6, 0x9a, 0x35, 0x55, 0xd9,
+	0xf8, 0x49, 0x01, 0x99, 0xa9, 0x5e, 0x83, 0x6b, 0xe0, 0x9a, 0xde, 0x30, 0x3a, 0x92, 0x5a, 0xdd,
+	0x31, 0xef, 0xeb, 0xa8, 0xfb, 0xb0, 0xad, 0xa3, 0xed, 0x72, 0xfd, 0xbe, 0xd1, 0xbc, 0xab, 0x26,
+	0x60, 0x0e, 0xac, 0x9e, 0x45, 0xa8, 0x1b, 0x4d, 0xbd, 0x6c, 0xaa, 0x0a, 0xfc, 0x3f, 0x58, 0x3b,
+	0x0b, 0xd7, 0x1f, 0xb4, 0x5b, 0x4d, 0xbd, 0xd9, 0x35, 0xca, 0x75, 0x75, 0x06, 0xfe, 0x0f, 0xdc,
+	0x38, 0x8b, 0xd4, 0x36, 0xf4, 0xaa, 0xbe, 0x6b, 0x74, 0x74, 0x75, 0x76, 0x03, 0x81, 0x4b, 0x93,
+	0xdf, 0x08, 0xf0, 0x1a, 0x58, 0x29, 0xef, 0x74, 0x5b, 0xa8, 0x61, 0x34, 0xbb, 0xa8, 0xd1, 0xaa,
+	0xe9, 0xa8, 0x66, 0x74, 0xca, 0x95, 0xba, 0x5e, 0x53, 0x13, 0x50, 0x03, 0xcb, 0xa7, 0xc0, 0x4a,
+	0xbd, 0x55, 0xbd, 0xa7, 0x2a, 0x67, 0x20, 0x7a, 0xbb, 0x55, 0xdd, 0x56, 0x67, 0x36, 0x3e, 0x05,
+	0x8b, 0x93, 0x3b, 0x3a, 0x0b, 0x32, 0x9d, 0x9d, 0x76, 0xbb, 0xfe, 0x10, 0x55, 0xca, 0x1d, 0xa3,
+	0x83, 0x2a, 0xe5, 0xe6, 0x3d, 0x35, 0x01, 0x57, 0xc0, 0xe5, 0x13, 0xc7, 0x35, 0xa3, 0xd3, 0x35,
+	0x5b, 0xaa, 0x52, 0xb9, 0xfb, 0xec, 0x55, 0x4e, 0x79, 0xfe, 0x2a, 0xa7, 0xfc, 0xf9, 0x2a, 0xa7,
+	0x7c, 0x7f, 0x94, 0x4b, 0x3c, 0x3f, 0xca, 0x25, 0x7e, 0x3b, 0xca, 0x25, 0xbe, 0xda, 0xec, 0x51,
+	0xde, 0x0f, 0xf7, 0x8a, 0x16, 0x73, 0x4a, 0xf2, 0x05, 0xd9, 0x74, 0x09, 0xdf, 0x67, 0xfe, 0xe3,
+	0xd2, 0xa9, 0x11, 0x21, 0x5e, 0xeb, 0x60, 0x6f, 0x4e, 0xce, 0xae, 0x0f, 0xfe, 0x19, 0x00, 0x0e,
+	0x92, 0x0a, 0xe2, 0x2d, 0x0b, 0x00, 0x00,
 }
 
 func (this *Params) Equal(that interface{}) bool {
@@ -169,7 +721,7 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.Denom != that1.Denom {
 		return false
 	}
-	if this.MaxSupply != that1.MaxSupply {
+	if this.LegacyMaxSupply != that1.LegacyMaxSupply {
 		return false
 	}
 	if this.DistributionStartDate != that1.DistributionStartDate {
@@ -178,61 +730,393 @@ func (this *Params) Equal(that interface{}) bool {
 	if this.MonthsInHalvingPeriod != that1.MonthsInHalvingPeriod {
 		return false
 	}
-	return true
-}
-func (m *Params) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+	if len(this.Recipients) != len(that1.Recipients) {
+		return false
 	}
-	return dAtA[:n], nil
-}
-
-func (m *Params) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
-}
-
-func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	if m.MonthsInHalvingPeriod != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MonthsInHalvingPeriod))
-		i--
-		dAtA[i] = 0x30
+	for i := range this.Recipients {
+		if !this.Recipients[i].Equal(&that1.Recipients[i]) {
+			return false
+		}
 	}
-	if len(m.DistributionStartDate) > 0 {
-		i -= len(m.DistributionStartDate)
-		copy(dAtA[i:], m.DistributionStartDate)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.DistributionStartDate)))
-		i--
-		dAtA[i] = 0x2a
+	if this.AutoMint != that1.AutoMint {
+		return false
 	}
-	if m.MaxSupply != 0 {
-		i = encodeVarintParams(dAtA, i, uint64(m.MaxSupply))
-		i--
-		dAtA[i] = 0x20
+	if this.AutoMintEpochIdentifier != that1.AutoMintEpochIdentifier {
+		return false
 	}
-	if len(m.Denom) > 0 {
-		i -= len(m.Denom)
-		copy(dAtA[i:], m.Denom)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
-		i--
-		dAtA[i] = 0x1a
+	if !this.MaxSupply.Equal(that1.MaxSupply) {
+		return false
 	}
-	if len(m.ReceivingAddress) > 0 {
-		i -= len(m.ReceivingAddress)
-		copy(dAtA[i:], m.ReceivingAddress)
-		i = encodeVarintParams(dAtA, i, uint64(len(m.ReceivingAddress)))
-		i--
-		dAtA[i] = 0x12
+	if this.MaxSupplyBasis != that1.MaxSupplyBasis {
+		return false
 	}
-	if len(m.MintingAddress) > 0 {
-		i -= len(m.MintingAddress)
+	if this.BurnsReopenMaxSupply != that1.BurnsReopenMaxSupply {
+		return false
+	}
+	if !this.MaxUnlockJump.Equal(that1.MaxUnlockJump) {
+		return false
+	}
+	if !this.EmissionCurve.Equal(&that1.EmissionCurve) {
+		return false
+	}
+	if this.SchedulePrecision != that1.SchedulePrecision {
+		return false
+	}
+	if !this.MaxMintAmount.Equal(that1.MaxMintAmount) {
+		return false
+	}
+	if this.MintWindow != that1.MintWindow {
+		return false
+	}
+	if !this.MaxWindowAmount.Equal(that1.MaxWindowAmount) {
+		return false
+	}
+	if this.MinMintInterval != that1.MinMintInterval {
+		return false
+	}
+	if this.Guardian != that1.Guardian {
+		return false
+	}
+	if len(this.MintApprovers) != len(that1.MintApprovers) {
+		return false
+	}
+	for i := range this.MintApprovers {
+		if this.MintApprovers[i] != that1.MintApprovers[i] {
+			return false
+		}
+	}
+	if this.MintApprovalThreshold != that1.MintApprovalThreshold {
+		return false
+	}
+	if this.MintProposalTtl != that1.MintProposalTtl {
+		return false
+	}
+	if len(this.MintDestinations) != len(that1.MintDestinations) {
+		return false
+	}
+	for i := range this.MintDestinations {
+		if this.MintDestinations[i] != that1.MintDestinations[i] {
+			return false
+		}
+	}
+	return true
+}
+func (this *EmissionCurve) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionCurve)
+	if !ok {
+		that2, ok := that.(EmissionCurve)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Type != that1.Type {
+		return false
+	}
+	if this.DurationMonths != that1.DurationMonths {
+		return false
+	}
+	if !this.DecayRatio.Equal(that1.DecayRatio) {
+		return false
+	}
+	if len(this.Points) != len(that1.Points) {
+		return false
+	}
+	for i := range this.Points {
+		if !this.Points[i].Equal(&that1.Points[i]) {
+			return false
+		}
+	}
+	return true
+}
+func (this *EmissionPoint) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*EmissionPoint)
+	if !ok {
+		that2, ok := that.(EmissionPoint)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Date != that1.Date {
+		return false
+	}
+	if !this.CumulativeCap.Equal(that1.CumulativeCap) {
+		return false
+	}
+	return true
+}
+func (this *Recipient) Equal(that interface{}) bool {
+	if that == nil {
+		return this == nil
+	}
+
+	that1, ok := that.(*Recipient)
+	if !ok {
+		that2, ok := that.(Recipient)
+		if ok {
+			that1 = &that2
+		} else {
+			return false
+		}
+	}
+	if that1 == nil {
+		return this == nil
+	} else if this == nil {
+		return false
+	}
+	if this.Address != that1.Address {
+		return false
+	}
+	if this.Module != that1.Module {
+		return false
+	}
+	if !this.Weight.Equal(that1.Weight) {
+		return false
+	}
+	return true
+}
+func (m *Params) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Params) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.MintDestinations) > 0 {
+		for iNdEx := len(m.MintDestinations) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MintDestinations[iNdEx])
+			copy(dAtA[i:], m.MintDestinations[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MintDestinations[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xc2
+		}
+	}
+	n1, err1 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MintProposalTtl, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintProposalTtl):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintParams(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0xba
+	if m.MintApprovalThreshold != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MintApprovalThreshold))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xb0
+	}
+	if len(m.MintApprovers) > 0 {
+		for iNdEx := len(m.MintApprovers) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.MintApprovers[iNdEx])
+			copy(dAtA[i:], m.MintApprovers[iNdEx])
+			i = encodeVarintParams(dAtA, i, uint64(len(m.MintApprovers[iNdEx])))
+			i--
+			dAtA[i] = 0x1
+			i--
+			dAtA[i] = 0xaa
+		}
+	}
+	if len(m.Guardian) > 0 {
+		i -= len(m.Guardian)
+		copy(dAtA[i:], m.Guardian)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Guardian)))
+		i--
+		dAtA[i] = 0x1
+		i--
+		dAtA[i] = 0xa2
+	}
+	n2, err2 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MinMintInterval, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinMintInterval):])
+	if err2 != nil {
+		return 0, err2
+	}
+	i -= n2
+	i = encodeVarintParams(dAtA, i, uint64(n2))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x9a
+	{
+		size := m.MaxWindowAmount.Size()
+		i -= size
+		if _, err := m.MaxWindowAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x92
+	n3, err3 := github_com_cosmos_gogoproto_types.StdDurationMarshalTo(m.MintWindow, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintWindow):])
+	if err3 != nil {
+		return 0, err3
+	}
+	i -= n3
+	i = encodeVarintParams(dAtA, i, uint64(n3))
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x8a
+	{
+		size := m.MaxMintAmount.Size()
+		i -= size
+		if _, err := m.MaxMintAmount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1
+	i--
+	dAtA[i] = 0x82
+	if m.SchedulePrecision != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.SchedulePrecision))
+		i--
+		dAtA[i] = 0x78
+	}
+	{
+		size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x72
+	{
+		size := m.MaxUnlockJump.Size()
+		i -= size
+		if _, err := m.MaxUnlockJump.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x6a
+	if m.BurnsReopenMaxSupply {
+		i--
+		if m.BurnsReopenMaxSupply {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x60
+	}
+	if m.MaxSupplyBasis != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MaxSupplyBasis))
+		i--
+		dAtA[i] = 0x58
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	if len(m.AutoMintEpochIdentifier) > 0 {
+		i -= len(m.AutoMintEpochIdentifier)
+		copy(dAtA[i:], m.AutoMintEpochIdentifier)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.AutoMintEpochIdentifier)))
+		i--
+		dAtA[i] = 0x4a
+	}
+	if m.AutoMint != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.AutoMint))
+		i--
+		dAtA[i] = 0x40
+	}
+	if len(m.Recipients) > 0 {
+		for iNdEx := len(m.Recipients) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Recipients[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x3a
+		}
+	}
+	if m.MonthsInHalvingPeriod != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.MonthsInHalvingPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if len(m.DistributionStartDate) > 0 {
+		i -= len(m.DistributionStartDate)
+		copy(dAtA[i:], m.DistributionStartDate)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.DistributionStartDate)))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.LegacyMaxSupply != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.LegacyMaxSupply))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Denom) > 0 {
+		i -= len(m.Denom)
+		copy(dAtA[i:], m.Denom)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Denom)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.ReceivingAddress) > 0 {
+		i -= len(m.ReceivingAddress)
+		copy(dAtA[i:], m.ReceivingAddress)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.ReceivingAddress)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.MintingAddress) > 0 {
+		i -= len(m.MintingAddress)
 		copy(dAtA[i:], m.MintingAddress)
 		i = encodeVarintParams(dAtA, i, uint64(len(m.MintingAddress)))
 		i--
@@ -241,55 +1125,1055 @@ func (m *Params) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
-func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
-	offset -= sovParams(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+func (m *EmissionCurve) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return dAtA[:n], nil
 }
-func (m *Params) Size() (n int) {
-	if m == nil {
-		return 0
-	}
+
+func (m *EmissionCurve) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionCurve) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
 	var l int
 	_ = l
-	l = len(m.MintingAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintParams(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x22
+		}
 	}
-	l = len(m.ReceivingAddress)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	{
+		size := m.DecayRatio.Size()
+		i -= size
+		if _, err := m.DecayRatio.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
 	}
-	l = len(m.Denom)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	i--
+	dAtA[i] = 0x1a
+	if m.DurationMonths != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.DurationMonths))
+		i--
+		dAtA[i] = 0x10
 	}
-	if m.MaxSupply != 0 {
-		n += 1 + sovParams(uint64(m.MaxSupply))
+	if m.Type != 0 {
+		i = encodeVarintParams(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x8
 	}
-	l = len(m.DistributionStartDate)
-	if l > 0 {
-		n += 1 + l + sovParams(uint64(l))
+	return len(dAtA) - i, nil
+}
+
+func (m *EmissionPoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
 	}
-	if m.MonthsInHalvingPeriod != 0 {
-		n += 1 + sovParams(uint64(m.MonthsInHalvingPeriod))
+	return dAtA[:n], nil
+}
+
+func (m *EmissionPoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *EmissionPoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.CumulativeCap.Size()
+		i -= size
+		if _, err := m.CumulativeCap.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *Recipient) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *Recipient) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *Recipient) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Weight.Size()
+		i -= size
+		if _, err := m.Weight.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintParams(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if len(m.Module) > 0 {
+		i -= len(m.Module)
+		copy(dAtA[i:], m.Module)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Module)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintParams(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParams(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParams(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *Params) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.MintingAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.ReceivingAddress)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Denom)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.LegacyMaxSupply != 0 {
+		n += 1 + sovParams(uint64(m.LegacyMaxSupply))
+	}
+	l = len(m.DistributionStartDate)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	if m.MonthsInHalvingPeriod != 0 {
+		n += 1 + sovParams(uint64(m.MonthsInHalvingPeriod))
+	}
+	if len(m.Recipients) > 0 {
+		for _, e := range m.Recipients {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	if m.AutoMint != 0 {
+		n += 1 + sovParams(uint64(m.AutoMint))
+	}
+	l = len(m.AutoMintEpochIdentifier)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.MaxSupplyBasis != 0 {
+		n += 1 + sovParams(uint64(m.MaxSupplyBasis))
+	}
+	if m.BurnsReopenMaxSupply {
+		n += 2
+	}
+	l = m.MaxUnlockJump.Size()
+	n += 1 + l + sovParams(uint64(l))
+	l = m.EmissionCurve.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if m.SchedulePrecision != 0 {
+		n += 1 + sovParams(uint64(m.SchedulePrecision))
+	}
+	l = m.MaxMintAmount.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintWindow)
+	n += 2 + l + sovParams(uint64(l))
+	l = m.MaxWindowAmount.Size()
+	n += 2 + l + sovParams(uint64(l))
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MinMintInterval)
+	n += 2 + l + sovParams(uint64(l))
+	l = len(m.Guardian)
+	if l > 0 {
+		n += 2 + l + sovParams(uint64(l))
+	}
+	if len(m.MintApprovers) > 0 {
+		for _, s := range m.MintApprovers {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	if m.MintApprovalThreshold != 0 {
+		n += 2 + sovParams(uint64(m.MintApprovalThreshold))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdDuration(m.MintProposalTtl)
+	n += 2 + l + sovParams(uint64(l))
+	if len(m.MintDestinations) > 0 {
+		for _, s := range m.MintDestinations {
+			l = len(s)
+			n += 2 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionCurve) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Type != 0 {
+		n += 1 + sovParams(uint64(m.Type))
+	}
+	if m.DurationMonths != 0 {
+		n += 1 + sovParams(uint64(m.DurationMonths))
+	}
+	l = m.DecayRatio.Size()
+	n += 1 + l + sovParams(uint64(l))
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovParams(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *EmissionPoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.CumulativeCap.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func (m *Recipient) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = len(m.Module)
+	if l > 0 {
+		n += 1 + l + sovParams(uint64(l))
+	}
+	l = m.Weight.Size()
+	n += 1 + l + sovParams(uint64(l))
+	return n
+}
+
+func sovParams(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParams(x uint64) (n int) {
+	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *Params) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingAddress", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.ReceivingAddress = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Denom = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field LegacyMaxSupply", wireType)
+			}
+			m.LegacyMaxSupply = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.LegacyMaxSupply |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionStartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsInHalvingPeriod", wireType)
+			}
+			m.MonthsInHalvingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsInHalvingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Recipients", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Recipients = append(m.Recipients, Recipient{})
+			if err := m.Recipients[len(m.Recipients)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMint", wireType)
+			}
+			m.AutoMint = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.AutoMint |= AutoMintMode(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMintEpochIdentifier", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.AutoMintEpochIdentifier = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupplyBasis", wireType)
+			}
+			m.MaxSupplyBasis = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxSupplyBasis |= SupplyBasis(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 12:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnsReopenMaxSupply", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.BurnsReopenMaxSupply = bool(v != 0)
+		case 13:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUnlockJump", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxUnlockJump.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 15:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SchedulePrecision", wireType)
+			}
+			m.SchedulePrecision = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SchedulePrecision |= SchedulePrecision(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 16:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxMintAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxMintAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 17:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintWindow", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MintWindow, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 18:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxWindowAmount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxWindowAmount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 19:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MinMintInterval", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MinMintInterval, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 20:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Guardian", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Guardian = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 21:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintApprovers", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintApprovers = append(m.MintApprovers, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 22:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintApprovalThreshold", wireType)
+			}
+			m.MintApprovalThreshold = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MintApprovalThreshold |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 23:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintProposalTtl", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdDurationUnmarshal(&m.MintProposalTtl, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 24:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintDestinations", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.MintDestinations = append(m.MintDestinations, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
 	}
-	return n
-}
 
-func sovParams(x uint64) (n int) {
-	return (math_bits.Len64(x|1) + 6) / 7
-}
-func sozParams(x uint64) (n int) {
-	return sovParams(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *Params) Unmarshal(dAtA []byte) error {
+func (m *EmissionCurve) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -312,15 +2196,53 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: Params: wiretype end group for non-group")
+			return fmt.Errorf("proto: EmissionCurve: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: Params: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: EmissionCurve: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= EmissionCurveType(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DurationMonths", wireType)
+			}
+			m.DurationMonths = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DurationMonths |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MintingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field DecayRatio", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -348,11 +2270,97 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.MintingAddress = string(dAtA[iNdEx:postIndex])
+			if err := m.DecayRatio.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 2:
+		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivingAddress", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParams
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, EmissionPoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *EmissionPoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: EmissionPoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: EmissionPoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -380,11 +2388,11 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.ReceivingAddress = string(dAtA[iNdEx:postIndex])
+			m.Date = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 3:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Denom", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field CumulativeCap", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -412,13 +2420,65 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.Denom = string(dAtA[iNdEx:postIndex])
+			if err := m.CumulativeCap.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
 			iNdEx = postIndex
-		case 4:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParams(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParams
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *Recipient) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParams
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: Recipient: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: Recipient: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
 			}
-			m.MaxSupply = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -428,14 +2488,27 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MaxSupply |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-		case 5:
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStartDate", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Module", wireType)
 			}
 			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
@@ -463,13 +2536,13 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.DistributionStartDate = string(dAtA[iNdEx:postIndex])
+			m.Module = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MonthsInHalvingPeriod", wireType)
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.MonthsInHalvingPeriod = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowParams
@@ -479,11 +2552,26 @@ func (m *Params) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.MonthsInHalvingPeriod |= uint64(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthParams
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthParams
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Weight.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipParams(dAtA[iNdEx:])
@@ -0,0 +1,228 @@
+package types_test
+
+import (
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/require"
+
+	"github.com/gnodi-network/gnodi/x/distro/types"
+)
+
+func TestParamsValidateRecipients(t *testing.T) {
+	const (
+		treasury = "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu"
+		vesting  = "gnodi1dz90dnylax5fvn9wzhrfln2ha73nehzvvr3hyz"
+	)
+
+	tests := []struct {
+		desc       string
+		recipients []types.Recipient
+		expErrMsg  string
+	}{
+		{
+			desc: "no recipients",
+		},
+		{
+			desc: "weights sum to one",
+			recipients: []types.Recipient{
+				types.NewRecipient(treasury, math.LegacyNewDecWithPrec(60, 2)),
+				types.NewModuleRecipient("distribution", math.LegacyNewDecWithPrec(25, 2)),
+				types.NewRecipient(vesting, math.LegacyNewDecWithPrec(15, 2)),
+			},
+		},
+		{
+			desc: "weights below one",
+			recipients: []types.Recipient{
+				types.NewRecipient(treasury, math.LegacyNewDecWithPrec(60, 2)),
+				types.NewRecipient(vesting, math.LegacyNewDecWithPrec(30, 2)),
+			},
+			expErrMsg: "recipient weights must sum to 1",
+		},
+		{
+			desc: "weights above one",
+			recipients: []types.Recipient{
+				types.NewRecipient(treasury, math.LegacyNewDecWithPrec(60, 2)),
+				types.NewRecipient(vesting, math.LegacyNewDecWithPrec(50, 2)),
+			},
+			expErrMsg: "recipient weights must sum to 1",
+		},
+		{
+			desc: "duplicate recipient",
+			recipients: []types.Recipient{
+				types.NewRecipient(treasury, math.LegacyNewDecWithPrec(50, 2)),
+				types.NewRecipient(treasury, math.LegacyNewDecWithPrec(50, 2)),
+			},
+			expErrMsg: "duplicate recipient",
+		},
+		{
+			desc: "address and module both set",
+			recipients: []types.Recipient{
+				{Address: treasury, Module: "distribution", Weight: math.LegacyOneDec()},
+			},
+			expErrMsg: "cannot set both",
+		},
+		{
+			desc: "neither address nor module set",
+			recipients: []types.Recipient{
+				{Weight: math.LegacyOneDec()},
+			},
+			expErrMsg: "either an address or a module",
+		},
+		{
+			desc: "invalid address",
+			recipients: []types.Recipient{
+				types.NewRecipient("notanaddress", math.LegacyOneDec()),
+			},
+			expErrMsg: "invalid recipient address",
+		},
+		{
+			desc: "zero weight",
+			recipients: []types.Recipient{
+				types.NewRecipient(treasury, math.LegacyOneDec()),
+				types.NewRecipient(vesting, math.LegacyZeroDec()),
+			},
+			expErrMsg: "recipient weight must be greater than 0",
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.NewParams(treasury, types.DefaultDenom, types.DefaultMaxSupply, types.DefaultDistributionStartDate, types.DefaultMonthsInHalvingPeriod)
+			params.Recipients = tc.recipients
+
+			err := params.Validate()
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestSplitAmount(t *testing.T) {
+	recipients := []types.Recipient{
+		types.NewRecipient("a", math.LegacyNewDecWithPrec(60, 2)),
+		types.NewRecipient("b", math.LegacyNewDecWithPrec(25, 2)),
+		types.NewRecipient("c", math.LegacyNewDecWithPrec(15, 2)),
+	}
+
+	parts, dust := types.SplitAmount(recipients, math.NewInt(1_000))
+	require.Equal(t, []string{"600", "250", "150"}, intStrings(parts))
+	require.True(t, dust.IsZero())
+
+	parts, dust = types.SplitAmount(recipients, math.NewInt(99))
+	require.Equal(t, []string{"59", "24", "14"}, intStrings(parts))
+	require.Equal(t, "2", dust.String())
+
+	parts, dust = types.SplitAmount(nil, math.NewInt(99))
+	require.Empty(t, parts)
+	require.Equal(t, "99", dust.String())
+}
+
+func intStrings(amounts []math.Int) []string {
+	strs := make([]string, len(amounts))
+	for i, amount := range amounts {
+		strs[i] = amount.String()
+	}
+	return strs
+}
+
+func TestParamsValidateAutoMint(t *testing.T) {
+	tests := []struct {
+		desc            string
+		mode            types.AutoMintMode
+		epochIdentifier string
+		expErrMsg       string
+	}{
+		{desc: "disabled", mode: types.AutoMintMode_AUTO_MINT_MODE_DISABLED},
+		{desc: "per block", mode: types.AutoMintMode_AUTO_MINT_MODE_BLOCK},
+		{desc: "per epoch", mode: types.AutoMintMode_AUTO_MINT_MODE_EPOCH, epochIdentifier: "day"},
+		{desc: "per epoch without identifier", mode: types.AutoMintMode_AUTO_MINT_MODE_EPOCH, expErrMsg: "epoch identifier cannot be empty"},
+		{desc: "unknown mode", mode: types.AutoMintMode(42), expErrMsg: "invalid auto mint mode"},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			params := types.NewParams("gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu", types.DefaultDenom, types.DefaultMaxSupply, types.DefaultDistributionStartDate, types.DefaultMonthsInHalvingPeriod)
+			params.AutoMint = tc.mode
+			params.AutoMintEpochIdentifier = tc.epochIdentifier
+
+			err := params.Validate()
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+			} else {
+				require.NoError(t, err)
+			}
+		})
+	}
+}
+
+func TestApplyParamsUpdate(t *testing.T) {
+	params := types.DefaultParams()
+	update := types.Params{
+		ReceivingAddress:        "gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu",
+		MaxSupply:               math.NewInt(1_000),
+		AutoMint:                types.AutoMintMode_AUTO_MINT_MODE_EPOCH,
+		AutoMintEpochIdentifier: "day",
+		MonthsInHalvingPeriod:   types.DefaultMonthsInHalvingPeriod,
+	}
+
+	tests := []struct {
+		desc       string
+		paths      []string
+		expChanges []types.ParamChange
+		expErrMsg  string
+	}{
+		{desc: "empty mask", expErrMsg: "update mask cannot be empty"},
+		{desc: "unknown field", paths: []string{"foo"}, expErrMsg: `unknown or immutable params field "foo"`},
+		{desc: "deprecated field", paths: []string{"legacy_max_supply"}, expErrMsg: `unknown or immutable params field "legacy_max_supply"`},
+		{desc: "duplicate field", paths: []string{"max_supply", "max_supply"}, expErrMsg: `duplicate params field "max_supply"`},
+		{desc: "invalid value", paths: []string{"denom"}, expErrMsg: "invalid denom"},
+		{desc: "missing dependent field", paths: []string{"auto_mint"}, expErrMsg: "auto mint epoch identifier cannot be empty"},
+		{
+			desc:  "dependent fields together",
+			paths: []string{"auto_mint", "auto_mint_epoch_identifier"},
+			expChanges: []types.ParamChange{
+				{Field: "auto_mint", Old: `"AUTO_MINT_MODE_DISABLED"`, New: `"AUTO_MINT_MODE_EPOCH"`},
+				{Field: "auto_mint_epoch_identifier", Old: `""`, New: `"day"`},
+			},
+		},
+		{
+			desc:  "unchanged fields are left out of the diff",
+			paths: []string{"receiving_address", "max_supply", "months_in_halving_period"},
+			expChanges: []types.ParamChange{
+				{Field: "receiving_address", Old: `""`, New: `"gnodi1znqekah4r9q8g69v9jt062xtl4ygjwy0n68uuu"`},
+				{Field: "max_supply", Old: `"35000000000000000"`, New: `"1000"`},
+			},
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.desc, func(t *testing.T) {
+			updated, changes, err := types.ApplyParamsUpdate(params, update, tc.paths)
+			if tc.expErrMsg != "" {
+				require.ErrorContains(t, err, tc.expErrMsg)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expChanges, changes)
+			require.Equal(t, params.Denom, updated.Denom)
+			require.Equal(t, params.DistributionStartDate, updated.DistributionStartDate)
+		})
+	}
+}
+
+func TestParseScheduleTime(t *testing.T) {
+	got, err := types.ParseScheduleTime("2025-07-22")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 7, 22, 0, 0, 0, 0, time.UTC), got)
+
+	got, err = types.ParseScheduleTime("2025-07-22T09:30:00+02:00")
+	require.NoError(t, err)
+	require.Equal(t, time.Date(2025, 7, 22, 7, 30, 0, 0, time.UTC), got)
+
+	for _, v := range []string{"", "22/07/2025", "2025-07-22T09:30:00"} {
+		_, err := types.ParseScheduleTime(v)
+		require.Error(t, err, v)
+	}
+}
@@ -0,0 +1,229 @@
+package types
+
+import (
+	"encoding/json"
+	"fmt"
+	"time"
+)
+
+// paramsField describes a Params field that can be updated on its own.
+type paramsField struct {
+	// set copies the field from src to dst.
+	set func(dst *Params, src Params)
+	// validate checks the field, together with the fields it depends on.
+	validate func(p Params) error
+	// value returns the field for the before/after diff.
+	value func(p Params) any
+}
+
+// paramsFields holds the updatable Params fields keyed by proto field name.
+// The deprecated fields are left out so that they cannot be set again.
+var paramsFields = map[string]paramsField{
+	"receiving_address": {
+		set:      func(dst *Params, src Params) { dst.ReceivingAddress = src.ReceivingAddress },
+		validate: func(p Params) error { return validateReceivingAddress(p.ReceivingAddress) },
+		value:    func(p Params) any { return p.ReceivingAddress },
+	},
+	"denom": {
+		set:      func(dst *Params, src Params) { dst.Denom = src.Denom },
+		validate: func(p Params) error { return validateDenom(p.Denom) },
+		value:    func(p Params) any { return p.Denom },
+	},
+	"max_supply": {
+		set: func(dst *Params, src Params) { dst.MaxSupply = src.MaxSupply },
+		validate: func(p Params) error {
+			if err := validateMaxSupply(p.MaxSupply); err != nil {
+				return err
+			}
+			return p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate)
+		},
+		value: func(p Params) any { return p.MaxSupply },
+	},
+	"distribution_start_date": {
+		set: func(dst *Params, src Params) { dst.DistributionStartDate = src.DistributionStartDate },
+		validate: func(p Params) error {
+			if err := validateDistributionStartDate(p.DistributionStartDate); err != nil {
+				return err
+			}
+			return p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate)
+		},
+		value: func(p Params) any { return p.DistributionStartDate },
+	},
+	"months_in_halving_period": {
+		set:      func(dst *Params, src Params) { dst.MonthsInHalvingPeriod = src.MonthsInHalvingPeriod },
+		validate: func(p Params) error { return validateMonthsInHalvingPeriod(p.MonthsInHalvingPeriod) },
+		value:    func(p Params) any { return p.MonthsInHalvingPeriod },
+	},
+	"recipients": {
+		set:      func(dst *Params, src Params) { dst.Recipients = src.Recipients },
+		validate: func(p Params) error { return validateRecipients(p.Recipients) },
+		value:    func(p Params) any { return p.Recipients },
+	},
+	"auto_mint": {
+		set:      func(dst *Params, src Params) { dst.AutoMint = src.AutoMint },
+		validate: func(p Params) error { return validateAutoMint(p.AutoMint, p.AutoMintEpochIdentifier) },
+		value:    func(p Params) any { return p.AutoMint.String() },
+	},
+	"auto_mint_epoch_identifier": {
+		set:      func(dst *Params, src Params) { dst.AutoMintEpochIdentifier = src.AutoMintEpochIdentifier },
+		validate: func(p Params) error { return validateAutoMint(p.AutoMint, p.AutoMintEpochIdentifier) },
+		value:    func(p Params) any { return p.AutoMintEpochIdentifier },
+	},
+	"max_supply_basis": {
+		set:      func(dst *Params, src Params) { dst.MaxSupplyBasis = src.MaxSupplyBasis },
+		validate: func(p Params) error { return validateSupplyBasis(p.MaxSupplyBasis) },
+		value:    func(p Params) any { return p.MaxSupplyBasis.String() },
+	},
+	"burns_reopen_max_supply": {
+		set:      func(dst *Params, src Params) { dst.BurnsReopenMaxSupply = src.BurnsReopenMaxSupply },
+		validate: func(Params) error { return nil },
+		value:    func(p Params) any { return p.BurnsReopenMaxSupply },
+	},
+	"max_unlock_jump": {
+		set:      func(dst *Params, src Params) { dst.MaxUnlockJump = src.MaxUnlockJump },
+		validate: func(p Params) error { return validateMaxUnlockJump(p.MaxUnlockJump) },
+		value:    func(p Params) any { return p.MaxUnlockJump },
+	},
+	"schedule_precision": {
+		set:      func(dst *Params, src Params) { dst.SchedulePrecision = src.SchedulePrecision },
+		validate: func(p Params) error { return validateSchedulePrecision(p.SchedulePrecision) },
+		value:    func(p Params) any { return p.SchedulePrecision.String() },
+	},
+	"max_mint_amount": {
+		set: func(dst *Params, src Params) { dst.MaxMintAmount = src.MaxMintAmount },
+		validate: func(p Params) error {
+			return validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval)
+		},
+		value: func(p Params) any { return p.MaxMintAmount },
+	},
+	"mint_window": {
+		set: func(dst *Params, src Params) { dst.MintWindow = src.MintWindow },
+		validate: func(p Params) error {
+			return validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval)
+		},
+		value: func(p Params) any { return p.MintWindow.String() },
+	},
+	"max_window_amount": {
+		set: func(dst *Params, src Params) { dst.MaxWindowAmount = src.MaxWindowAmount },
+		validate: func(p Params) error {
+			return validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval)
+		},
+		value: func(p Params) any { return p.MaxWindowAmount },
+	},
+	"min_mint_interval": {
+		set: func(dst *Params, src Params) { dst.MinMintInterval = src.MinMintInterval },
+		validate: func(p Params) error {
+			return validateMintRateLimits(p.MaxMintAmount, p.MintWindow, p.MaxWindowAmount, p.MinMintInterval)
+		},
+		value: func(p Params) any { return p.MinMintInterval.String() },
+	},
+	"guardian": {
+		set:      func(dst *Params, src Params) { dst.Guardian = src.Guardian },
+		validate: func(p Params) error { return validateGuardian(p.Guardian) },
+		value:    func(p Params) any { return p.Guardian },
+	},
+	"mint_approvers": {
+		set: func(dst *Params, src Params) { dst.MintApprovers = src.MintApprovers },
+		validate: func(p Params) error {
+			return validateMintApprovals(p.MintApprovers, p.MintApprovalThreshold, p.MintProposalTtl)
+		},
+		value: func(p Params) any { return p.MintApprovers },
+	},
+	"mint_approval_threshold": {
+		set: func(dst *Params, src Params) { dst.MintApprovalThreshold = src.MintApprovalThreshold },
+		validate: func(p Params) error {
+			return validateMintApprovals(p.MintApprovers, p.MintApprovalThreshold, p.MintProposalTtl)
+		},
+		value: func(p Params) any { return p.MintApprovalThreshold },
+	},
+	"mint_proposal_ttl": {
+		set: func(dst *Params, src Params) { dst.MintProposalTtl = src.MintProposalTtl },
+		validate: func(p Params) error {
+			return validateMintApprovals(p.MintApprovers, p.MintApprovalThreshold, p.MintProposalTtl)
+		},
+		value: func(p Params) any { return p.MintProposalTtl.String() },
+	},
+	"mint_destinations": {
+		set:      func(dst *Params, src Params) { dst.MintDestinations = src.MintDestinations },
+		validate: func(p Params) error { return validateMintDestinations(p.MintDestinations) },
+		value:    func(p Params) any { return p.MintDestinations },
+	},
+	"emission_curve": {
+		set: func(dst *Params, src Params) { dst.EmissionCurve = src.EmissionCurve },
+		validate: func(p Params) error {
+			return p.EmissionCurve.Validate(p.MaxSupply, p.DistributionStartDate)
+		},
+		value: func(p Params) any { return p.EmissionCurve },
+	},
+}
+
+// ApplyParamsUpdate returns params with the fields named by paths replaced by
+// the ones of update, together with the before/after diff of the fields that
+// changed. Only the replaced fields are validated, so a partial update never
+// fails on a field it does not touch.
+func ApplyParamsUpdate(params, update Params, paths []string) (Params, []ParamChange, error) {
+	if len(paths) == 0 {
+		return Params{}, nil, fmt.Errorf("update mask cannot be empty")
+	}
+
+	updated := params
+	seen := make(map[string]struct{}, len(paths))
+	for _, path := range paths {
+		field, ok := paramsFields[path]
+		if !ok {
+			return Params{}, nil, fmt.Errorf("unknown or immutable params field %q", path)
+		}
+		if _, ok := seen[path]; ok {
+			return Params{}, nil, fmt.Errorf("duplicate params field %q", path)
+		}
+		seen[path] = struct{}{}
+		field.set(&updated, update)
+	}
+
+	var changes []ParamChange
+	for _, path := range paths {
+		field := paramsFields[path]
+		if err := field.validate(updated); err != nil {
+			return Params{}, nil, err
+		}
+
+		oldValue, err := json.Marshal(field.value(params))
+		if err != nil {
+			return Params{}, nil, err
+		}
+		newValue, err := json.Marshal(field.value(updated))
+		if err != nil {
+			return Params{}, nil, err
+		}
+		if string(oldValue) != string(newValue) {
+			changes = append(changes, ParamChange{Field: path, Old: string(oldValue), New: string(newValue)})
+		}
+	}
+
+	return updated, changes, nil
+}
+
+// ValidateActivation checks that exactly one of the activation height and
+// time of the update is set.
+func (u ScheduledParamsUpdate) ValidateActivation() error {
+	hasHeight := u.ActivationHeight != 0
+	hasTime := u.ActivationTime != nil && !u.ActivationTime.IsZero()
+	switch {
+	case hasHeight && hasTime:
+		return fmt.Errorf("only one of activation height and activation time can be set")
+	case !hasHeight && !hasTime:
+		return fmt.Errorf("activation height or activation time must be set")
+	case u.ActivationHeight < 0:
+		return fmt.Errorf("activation height cannot be negative")
+	}
+	return nil
+}
+
+// IsDue reports whether the update is active at the given block height and
+// time.
+func (u ScheduledParamsUpdate) IsDue(height int64, blockTime time.Time) bool {
+	if u.ActivationTime != nil && !u.ActivationTime.IsZero() {
+		return !blockTime.Before(*u.ActivationTime)
+	}
+	return height >= u.ActivationHeight
+}
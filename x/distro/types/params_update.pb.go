@@ -0,0 +1,619 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gnodi/distro/v1/params_update.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// ScheduledParamsUpdate is a params update that waits in the pending queue
+// until its activation height or time is reached. Exactly one of
+// activation_height and activation_time is set.
+type ScheduledParamsUpdate struct {
+	// id is the sequence number of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	// params holds the new params. When update_mask is set, only the fields it
+	// names are applied.
+	Params Params `protobuf:"bytes,2,opt,name=params,proto3" json:"params"`
+	// update_mask names the params fields to update. When it is unset, params
+	// replaces the current params as a whole.
+	UpdateMask *types.FieldMask `protobuf:"bytes,3,opt,name=update_mask,json=updateMask,proto3" json:"update_mask,omitempty"`
+	// activation_height is the block height from which the update applies.
+	ActivationHeight int64 `protobuf:"varint,4,opt,name=activation_height,json=activationHeight,proto3" json:"activation_height,omitempty"`
+	// activation_time is the block time from which the update applies.
+	ActivationTime *time.Time `protobuf:"bytes,5,opt,name=activation_time,json=activationTime,proto3,stdtime" json:"activation_time,omitempty"`
+	// scheduled_height is the block height the update was scheduled at.
+	ScheduledHeight int64 `protobuf:"varint,6,opt,name=scheduled_height,json=scheduledHeight,proto3" json:"scheduled_height,omitempty"`
+	// override_schedule_guard skips the max unlock jump of the schedule guard
+	// when the update activates. The minted supply check of the guard always
+	// applies.
+	OverrideScheduleGuard bool `protobuf:"varint,7,opt,name=override_schedule_guard,json=overrideScheduleGuard,proto3" json:"override_schedule_guard,omitempty"`
+}
+
+func (m *ScheduledParamsUpdate) Reset()         { *m = ScheduledParamsUpdate{} }
+func (m *ScheduledParamsUpdate) String() string { return proto.CompactTextString(m) }
+func (*ScheduledParamsUpdate) ProtoMessage()    {}
+func (*ScheduledParamsUpdate) Descriptor() ([]byte, []int) {
+	return fileDescriptor_ba2982cb84b6398d, []int{0}
+}
+func (m *ScheduledParamsUpdate) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduledParamsUpdate) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduledParamsUpdate.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduledParamsUpdate) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduledParamsUpdate.Merge(m, src)
+}
+func (m *ScheduledParamsUpdate) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduledParamsUpdate) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduledParamsUpdate.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduledParamsUpdate proto.InternalMessageInfo
+
+func (m *ScheduledParamsUpdate) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+func (m *ScheduledParamsUpdate) GetParams() Params {
+	if m != nil {
+		return m.Params
+	}
+	return Params{}
+}
+
+func (m *ScheduledParamsUpdate) GetUpdateMask() *types.FieldMask {
+	if m != nil {
+		return m.UpdateMask
+	}
+	return nil
+}
+
+func (m *ScheduledParamsUpdate) GetActivationHeight() int64 {
+	if m != nil {
+		return m.ActivationHeight
+	}
+	return 0
+}
+
+func (m *ScheduledParamsUpdate) GetActivationTime() *time.Time {
+	if m != nil {
+		return m.ActivationTime
+	}
+	return nil
+}
+
+func (m *ScheduledParamsUpdate) GetScheduledHeight() int64 {
+	if m != nil {
+		return m.ScheduledHeight
+	}
+	return 0
+}
+
+func (m *ScheduledParamsUpdate) GetOverrideScheduleGuard() bool {
+	if m != nil {
+		return m.OverrideScheduleGuard
+	}
+	return false
+}
+
+func init() {
+	proto.RegisterType((*ScheduledParamsUpdate)(nil), "gnodi.distro.v1.ScheduledParamsUpdate")
+}
+
+func init() {
+	proto.RegisterFile("gnodi/distro/v1/params_update.proto", fileDescriptor_ba2982cb84b6398d)
+}
+
+var fileDescriptor_ba2982cb84b6398d = []byte{
+	// 416 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x31, 0x8f, 0xd3, 0x30,
+	0x14, 0x8e, 0x73, 0xa5, 0x80, 0x4f, 0xba, 0xde, 0x45, 0x9c, 0x2e, 0xaa, 0x50, 0x1a, 0xc1, 0x12,
+	0x40, 0x67, 0xeb, 0x40, 0x62, 0x80, 0xad, 0x03, 0x85, 0x01, 0x09, 0x05, 0x58, 0x58, 0x22, 0xb7,
+	0x76, 0x1d, 0xab, 0x4d, 0x1c, 0x25, 0x4e, 0x80, 0x7f, 0xd1, 0x9f, 0xc1, 0xc8, 0xcf, 0xe8, 0xd8,
+	0x91, 0x09, 0x50, 0x3b, 0xf0, 0x23, 0x58, 0x50, 0xec, 0x98, 0xa2, 0x8a, 0x5b, 0xa2, 0xe7, 0xf7,
+	0x7d, 0xef, 0x7d, 0xdf, 0xfb, 0x14, 0x78, 0x9f, 0xe7, 0x92, 0x0a, 0x4c, 0x45, 0xa5, 0x4a, 0x89,
+	0x9b, 0x2b, 0x5c, 0x90, 0x92, 0x64, 0x55, 0x52, 0x17, 0x94, 0x28, 0x86, 0x8a, 0x52, 0x2a, 0xe9,
+	0x0d, 0x34, 0x09, 0x19, 0x12, 0x6a, 0xae, 0x86, 0x67, 0x24, 0x13, 0xb9, 0xc4, 0xfa, 0x6b, 0x38,
+	0xc3, 0xbb, 0xff, 0x5f, 0xd4, 0xa1, 0x77, 0xb8, 0xe4, 0x52, 0x97, 0xb8, 0xad, 0xba, 0x6e, 0xc8,
+	0xa5, 0xe4, 0x4b, 0x86, 0xf5, 0x6b, 0x5a, 0xcf, 0xf1, 0x5c, 0xb0, 0x25, 0x4d, 0x32, 0x52, 0x2d,
+	0x3a, 0xc6, 0xe8, 0x90, 0xa1, 0x44, 0xc6, 0x2a, 0x45, 0xb2, 0xc2, 0x10, 0xee, 0xfd, 0x76, 0xe1,
+	0xf9, 0xdb, 0x59, 0xca, 0x68, 0xbd, 0x64, 0xf4, 0x8d, 0x96, 0x7c, 0xaf, 0xad, 0x7b, 0x27, 0xd0,
+	0x15, 0xd4, 0x07, 0x21, 0x88, 0x7a, 0xb1, 0x2b, 0xa8, 0xf7, 0x0c, 0xf6, 0x8d, 0x25, 0xdf, 0x0d,
+	0x41, 0x74, 0xfc, 0xf8, 0x02, 0x1d, 0x5c, 0x85, 0xcc, 0xf8, 0xf8, 0xf6, 0xfa, 0xfb, 0xc8, 0xf9,
+	0xf2, 0xeb, 0xeb, 0x43, 0x10, 0x77, 0x13, 0xde, 0x73, 0x78, 0x6c, 0x02, 0xd1, 0xde, 0xfc, 0x23,
+	0xbd, 0x60, 0x88, 0x8c, 0x39, 0x64, 0xcd, 0xa1, 0x17, 0xad, 0xfd, 0xd7, 0xa4, 0x5a, 0xc4, 0xd0,
+	0xd0, 0xdb, 0xda, 0x7b, 0x04, 0xcf, 0xc8, 0x4c, 0x89, 0x86, 0x28, 0x21, 0xf3, 0x24, 0x65, 0x82,
+	0xa7, 0xca, 0xef, 0x85, 0x20, 0x3a, 0x8a, 0x4f, 0xf7, 0xc0, 0x4b, 0xdd, 0xf7, 0x5e, 0xc1, 0xc1,
+	0x3f, 0xe4, 0xf6, 0x5a, 0xff, 0xc6, 0x35, 0x6a, 0xef, 0x6c, 0x14, 0xe3, 0xde, 0xea, 0xc7, 0x08,
+	0xc4, 0x27, 0xfb, 0xc1, 0x16, 0xf2, 0x1e, 0xc0, 0xd3, 0xca, 0x26, 0x63, 0x65, 0xfb, 0x5a, 0x76,
+	0xf0, 0xb7, 0xdf, 0xa9, 0x3e, 0x85, 0x17, 0xb2, 0x61, 0x65, 0x29, 0x28, 0x4b, 0x2c, 0x96, 0xf0,
+	0x9a, 0x94, 0xd4, 0xbf, 0x19, 0x82, 0xe8, 0x56, 0x7c, 0x6e, 0x61, 0x9b, 0xf5, 0xa4, 0x05, 0xc7,
+	0x93, 0xf5, 0x36, 0x00, 0x9b, 0x6d, 0x00, 0x7e, 0x6e, 0x03, 0xb0, 0xda, 0x05, 0xce, 0x66, 0x17,
+	0x38, 0xdf, 0x76, 0x81, 0xf3, 0xe1, 0x92, 0x0b, 0x95, 0xd6, 0x53, 0x34, 0x93, 0x19, 0xd6, 0x39,
+	0x5f, 0xe6, 0x4c, 0x7d, 0x94, 0xe5, 0xc2, 0xbc, 0xf0, 0x27, 0xfb, 0xa7, 0xa8, 0xcf, 0x05, 0xab,
+	0xa6, 0x7d, 0x7d, 0xd5, 0x93, 0x3f, 0x03, 0x00, 0xdb, 0xbb, 0xe4, 0x81, 0x8f, 0x02, 0x00, 0x00,
+}
+
+func (m *ScheduledParamsUpdate) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduledParamsUpdate) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduledParamsUpdate) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.OverrideScheduleGuard {
+		i--
+		if m.OverrideScheduleGuard {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x38
+	}
+	if m.ScheduledHeight != 0 {
+		i = encodeVarintParamsUpdate(dAtA, i, uint64(m.ScheduledHeight))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.ActivationTime != nil {
+		n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(*m.ActivationTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime):])
+		if err1 != nil {
+			return 0, err1
+		}
+		i -= n1
+		i = encodeVarintParamsUpdate(dAtA, i, uint64(n1))
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.ActivationHeight != 0 {
+		i = encodeVarintParamsUpdate(dAtA, i, uint64(m.ActivationHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.UpdateMask != nil {
+		{
+			size, err := m.UpdateMask.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintParamsUpdate(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintParamsUpdate(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	if m.Id != 0 {
+		i = encodeVarintParamsUpdate(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintParamsUpdate(dAtA []byte, offset int, v uint64) int {
+	offset -= sovParamsUpdate(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *ScheduledParamsUpdate) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovParamsUpdate(uint64(m.Id))
+	}
+	l = m.Params.Size()
+	n += 1 + l + sovParamsUpdate(uint64(l))
+	if m.UpdateMask != nil {
+		l = m.UpdateMask.Size()
+		n += 1 + l + sovParamsUpdate(uint64(l))
+	}
+	if m.ActivationHeight != 0 {
+		n += 1 + sovParamsUpdate(uint64(m.ActivationHeight))
+	}
+	if m.ActivationTime != nil {
+		l = github_com_cosmos_gogoproto_types.SizeOfStdTime(*m.ActivationTime)
+		n += 1 + l + sovParamsUpdate(uint64(l))
+	}
+	if m.ScheduledHeight != 0 {
+		n += 1 + sovParamsUpdate(uint64(m.ScheduledHeight))
+	}
+	if m.OverrideScheduleGuard {
+		n += 2
+	}
+	return n
+}
+
+func sovParamsUpdate(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozParamsUpdate(x uint64) (n int) {
+	return sovParamsUpdate(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *ScheduledParamsUpdate) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowParamsUpdate
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduledParamsUpdate: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UpdateMask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.UpdateMask == nil {
+				m.UpdateMask = &types.FieldMask{}
+			}
+			if err := m.UpdateMask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationHeight", wireType)
+			}
+			m.ActivationHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ActivationHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ActivationTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.ActivationTime == nil {
+				m.ActivationTime = new(time.Time)
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(m.ActivationTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ScheduledHeight", wireType)
+			}
+			m.ScheduledHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ScheduledHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field OverrideScheduleGuard", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.OverrideScheduleGuard = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipParamsUpdate(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthParamsUpdate
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipParamsUpdate(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowParamsUpdate
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowParamsUpdate
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthParamsUpdate
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupParamsUpdate
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthParamsUpdate
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthParamsUpdate        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowParamsUpdate          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupParamsUpdate = fmt.Errorf("proto: unexpected end of group")
+)
@@ -0,0 +1,517 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: gnodi/distro/v1/pause.proto
+
+package types
+
+import (
+	fmt "fmt"
+	_ "github.com/cosmos/cosmos-proto"
+	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
+	_ "github.com/cosmos/gogoproto/gogoproto"
+	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+	time "time"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+var _ = time.Kitchen
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// PauseStatus records whether minting is paused, and by whom.
+type PauseStatus struct {
+	// paused is true while minting is paused.
+	Paused bool `protobuf:"varint,1,opt,name=paused,proto3" json:"paused,omitempty"`
+	// paused_by is the authority or guardian address that paused minting.
+	PausedBy string `protobuf:"bytes,2,opt,name=paused_by,json=pausedBy,proto3" json:"paused_by,omitempty"`
+	// reason is the reason given when pausing.
+	Reason string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	// block_height is the height of the block minting was paused at.
+	BlockHeight int64 `protobuf:"varint,4,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// block_time is the time of the block minting was paused at.
+	BlockTime time.Time `protobuf:"bytes,5,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+}
+
+func (m *PauseStatus) Reset()         { *m = PauseStatus{} }
+func (m *PauseStatus) String() string { return proto.CompactTextString(m) }
+func (*PauseStatus) ProtoMessage()    {}
+func (*PauseStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a44fda0a1229672d, []int{0}
+}
+func (m *PauseStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *PauseStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_PauseStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *PauseStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_PauseStatus.Merge(m, src)
+}
+func (m *PauseStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *PauseStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_PauseStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_PauseStatus proto.InternalMessageInfo
+
+func (m *PauseStatus) GetPaused() bool {
+	if m != nil {
+		return m.Paused
+	}
+	return false
+}
+
+func (m *PauseStatus) GetPausedBy() string {
+	if m != nil {
+		return m.PausedBy
+	}
+	return ""
+}
+
+func (m *PauseStatus) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *PauseStatus) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
+}
+
+func (m *PauseStatus) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
+	}
+	return time.Time{}
+}
+
+func init() {
+	proto.RegisterType((*PauseStatus)(nil), "gnodi.distro.v1.PauseStatus")
+}
+
+func init() { proto.RegisterFile("gnodi/distro/v1/pause.proto", fileDescriptor_a44fda0a1229672d) }
+
+var fileDescriptor_a44fda0a1229672d = []byte{
+	// 344 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x34, 0x51, 0xcd, 0x4e, 0xf2, 0x40,
+	0x14, 0xed, 0x7c, 0x7c, 0x12, 0x28, 0x1a, 0x63, 0x43, 0x4c, 0xc5, 0xa4, 0x54, 0x57, 0x8d, 0x09,
+	0x33, 0x41, 0xe3, 0x03, 0xd8, 0x8d, 0x2c, 0x4d, 0x71, 0xe5, 0x86, 0xb4, 0x74, 0x1c, 0x26, 0xd0,
+	0xde, 0xa6, 0x33, 0x45, 0x79, 0x0b, 0x1e, 0xc3, 0xa5, 0x0b, 0x1f, 0x82, 0x25, 0x71, 0xe5, 0xca,
+	0x1f, 0x58, 0xf8, 0x1a, 0xa6, 0x33, 0x65, 0xd3, 0xdc, 0xf3, 0x73, 0x3b, 0xe7, 0xcc, 0x98, 0xa7,
+	0x2c, 0x85, 0x98, 0x93, 0x98, 0x0b, 0x99, 0x03, 0x99, 0xf7, 0x49, 0x16, 0x16, 0x82, 0xe2, 0x2c,
+	0x07, 0x09, 0xd6, 0xa1, 0x12, 0xb1, 0x16, 0xf1, 0xbc, 0xdf, 0x39, 0x0a, 0x13, 0x9e, 0x02, 0x51,
+	0x5f, 0xed, 0xe9, 0x9c, 0x8c, 0x41, 0x24, 0x20, 0x46, 0x0a, 0x11, 0x0d, 0x2a, 0xa9, 0xcd, 0x80,
+	0x81, 0xe6, 0xcb, 0xa9, 0x62, 0xbb, 0x0c, 0x80, 0xcd, 0x28, 0x51, 0x28, 0x2a, 0x1e, 0x89, 0xe4,
+	0x09, 0x15, 0x32, 0x4c, 0x32, 0x6d, 0x38, 0xff, 0x41, 0x66, 0xeb, 0xae, 0x4c, 0x31, 0x94, 0xa1,
+	0x2c, 0x84, 0x75, 0x6c, 0xd6, 0x55, 0xa8, 0xd8, 0x46, 0x2e, 0xf2, 0x1a, 0x41, 0x85, 0xac, 0x6b,
+	0xb3, 0xa9, 0xa7, 0x51, 0xb4, 0xb0, 0xff, 0xb9, 0xc8, 0x6b, 0xfa, 0xf6, 0xfb, 0x5b, 0xaf, 0x5d,
+	0x65, 0xb8, 0x89, 0xe3, 0x9c, 0x0a, 0x31, 0x94, 0x39, 0x4f, 0x59, 0xd0, 0xd0, 0x56, 0x7f, 0x51,
+	0xfe, 0x2e, 0xa7, 0xa1, 0x80, 0xd4, 0xae, 0x95, 0x3b, 0x41, 0x85, 0xac, 0x33, 0x73, 0x3f, 0x9a,
+	0xc1, 0x78, 0x3a, 0x9a, 0x50, 0xce, 0x26, 0xd2, 0xfe, 0xef, 0x22, 0xaf, 0x16, 0xb4, 0x14, 0x37,
+	0x50, 0x94, 0x35, 0x30, 0x4d, 0x6d, 0x29, 0x23, 0xdb, 0x7b, 0x2e, 0xf2, 0x5a, 0x97, 0x1d, 0xac,
+	0xfb, 0xe0, 0x5d, 0x1f, 0x7c, 0xbf, 0xeb, 0xe3, 0x1f, 0xac, 0x3e, 0xbb, 0xc6, 0xf2, 0xab, 0x8b,
+	0x5e, 0x7e, 0x5f, 0x2f, 0x50, 0xd0, 0x54, 0xcb, 0xa5, 0xec, 0xdf, 0xae, 0x36, 0x0e, 0x5a, 0x6f,
+	0x1c, 0xf4, 0xbd, 0x71, 0xd0, 0x72, 0xeb, 0x18, 0xeb, 0xad, 0x63, 0x7c, 0x6c, 0x1d, 0xe3, 0xa1,
+	0xc7, 0xb8, 0x9c, 0x14, 0x11, 0x1e, 0x43, 0x42, 0xd4, 0xf5, 0xf7, 0x52, 0x2a, 0x9f, 0x20, 0x9f,
+	0x6a, 0x44, 0x9e, 0x77, 0x6f, 0x25, 0x17, 0x19, 0x15, 0x51, 0x5d, 0x1d, 0x7b, 0xf5, 0x37, 0x00,
+	0x35, 0x4e, 0x3e, 0x56, 0xc8, 0x01, 0x00, 0x00,
+}
+
+func (m *PauseStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *PauseStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *PauseStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	n1, err1 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err1 != nil {
+		return 0, err1
+	}
+	i -= n1
+	i = encodeVarintPause(dAtA, i, uint64(n1))
+	i--
+	dAtA[i] = 0x2a
+	if m.BlockHeight != 0 {
+		i = encodeVarintPause(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x20
+	}
+	if len(m.Reason) > 0 {
+		i -= len(m.Reason)
+		copy(dAtA[i:], m.Reason)
+		i = encodeVarintPause(dAtA, i, uint64(len(m.Reason)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if len(m.PausedBy) > 0 {
+		i -= len(m.PausedBy)
+		copy(dAtA[i:], m.PausedBy)
+		i = encodeVarintPause(dAtA, i, uint64(len(m.PausedBy)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Paused {
+		i--
+		if m.Paused {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintPause(dAtA []byte, offset int, v uint64) int {
+	offset -= sovPause(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *PauseStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Paused {
+		n += 2
+	}
+	l = len(m.PausedBy)
+	if l > 0 {
+		n += 1 + l + sovPause(uint64(l))
+	}
+	l = len(m.Reason)
+	if l > 0 {
+		n += 1 + l + sovPause(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovPause(uint64(m.BlockHeight))
+	}
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovPause(uint64(l))
+	return n
+}
+
+func sovPause(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozPause(x uint64) (n int) {
+	return sovPause(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *PauseStatus) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: PauseStatus: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: PauseStatus: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Paused", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.Paused = bool(v != 0)
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PausedBy", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPause
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPause
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PausedBy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Reason", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthPause
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthPause
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Reason = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthPause
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthPause
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipPause(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthPause
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipPause(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowPause
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowPause
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthPause
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupPause
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthPause
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthPause        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowPause          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupPause = fmt.Errorf("proto: unexpected end of group")
+)
@@ -5,25 +5,31 @@ package types
 
 import (
 	context "context"
+	cosmossdk_io_math "cosmossdk.io/math"
 	fmt "fmt"
-	_ "github.com/cosmos/cosmos-sdk/types/query"
+	_ "github.com/cosmos/cosmos-proto"
+	query "github.com/cosmos/cosmos-sdk/types/query"
 	_ "github.com/cosmos/cosmos-sdk/types/tx/amino"
 	_ "github.com/cosmos/gogoproto/gogoproto"
 	grpc1 "github.com/cosmos/gogoproto/grpc"
 	proto "github.com/cosmos/gogoproto/proto"
+	github_com_cosmos_gogoproto_types "github.com/cosmos/gogoproto/types"
 	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
+	_ "google.golang.org/protobuf/types/known/timestamppb"
 	io "io"
 	math "math"
 	math_bits "math/bits"
+	time "time"
 )
 
 // Reference imports to suppress errors if they are not otherwise used.
 var _ = proto.Marshal
 var _ = fmt.Errorf
 var _ = math.Inf
+var _ = time.Kitchen
 
 // This is a compile-time assertion to ensure that this generated file
 // is compatible with the proto package it is being compiled against.
@@ -31,6 +37,43 @@ var _ = math.Inf
 // proto package needs to be updated.
 const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
 
+// ProjectionGranularity defines the step between two projected points.
+type ProjectionGranularity int32
+
+const (
+	// PROJECTION_GRANULARITY_UNSPECIFIED defaults to a daily projection.
+	ProjectionGranularity_PROJECTION_GRANULARITY_UNSPECIFIED ProjectionGranularity = 0
+	// PROJECTION_GRANULARITY_DAY projects one point per day.
+	ProjectionGranularity_PROJECTION_GRANULARITY_DAY ProjectionGranularity = 1
+	// PROJECTION_GRANULARITY_MONTH projects one point per calendar month.
+	ProjectionGranularity_PROJECTION_GRANULARITY_MONTH ProjectionGranularity = 2
+	// PROJECTION_GRANULARITY_PERIOD projects one point per halving period
+	// boundary.
+	ProjectionGranularity_PROJECTION_GRANULARITY_PERIOD ProjectionGranularity = 3
+)
+
+var ProjectionGranularity_name = map[int32]string{
+	0: "PROJECTION_GRANULARITY_UNSPECIFIED",
+	1: "PROJECTION_GRANULARITY_DAY",
+	2: "PROJECTION_GRANULARITY_MONTH",
+	3: "PROJECTION_GRANULARITY_PERIOD",
+}
+
+var ProjectionGranularity_value = map[string]int32{
+	"PROJECTION_GRANULARITY_UNSPECIFIED": 0,
+	"PROJECTION_GRANULARITY_DAY":         1,
+	"PROJECTION_GRANULARITY_MONTH":       2,
+	"PROJECTION_GRANULARITY_PERIOD":      3,
+}
+
+func (x ProjectionGranularity) String() string {
+	return proto.EnumName(ProjectionGranularity_name, int32(x))
+}
+
+func (ProjectionGranularity) EnumDescriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{0}
+}
+
 // QueryParamsRequest is request type for the Query/Params RPC method.
 type QueryParamsRequest struct {
 }
@@ -114,215 +157,7191 @@ func (m *QueryParamsResponse) GetParams() Params {
 	return Params{}
 }
 
-func init() {
-	proto.RegisterType((*QueryParamsRequest)(nil), "gnodi.distro.v1.QueryParamsRequest")
-	proto.RegisterType((*QueryParamsResponse)(nil), "gnodi.distro.v1.QueryParamsResponse")
+// QueryMintsRequest is request type for the Query/Mints RPC method.
+type QueryMintsRequest struct {
+	// signer, if set, restricts the results to mints signed by this address.
+	Signer string `protobuf:"bytes,1,opt,name=signer,proto3" json:"signer,omitempty"`
+	// block_height, if set, restricts the results to mints included at this
+	// height. It is ignored when signer is set.
+	BlockHeight int64 `protobuf:"varint,2,opt,name=block_height,json=blockHeight,proto3" json:"block_height,omitempty"`
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,3,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func init() { proto.RegisterFile("gnodi/distro/v1/query.proto", fileDescriptor_27b0f6ceb4113d2c) }
-
-var fileDescriptor_27b0f6ceb4113d2c = []byte{
-	// 323 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x7c, 0x90, 0xb1, 0x4b, 0xc3, 0x40,
-	0x14, 0xc6, 0x73, 0x82, 0x05, 0xe3, 0x20, 0xc6, 0x82, 0x52, 0x25, 0x4a, 0x55, 0x94, 0x42, 0xf3,
-	0x48, 0xdd, 0x1c, 0xbb, 0xb8, 0xda, 0x8e, 0x6e, 0x97, 0xf6, 0x38, 0x0f, 0xcd, 0xbd, 0x6b, 0xee,
-	0x5a, 0xed, 0xda, 0xd9, 0x41, 0xf0, 0x9f, 0x70, 0xf4, 0xcf, 0xe8, 0x58, 0x70, 0x71, 0x12, 0x69,
-	0x04, 0xff, 0x0d, 0xe9, 0x5d, 0x14, 0x6c, 0x8b, 0x4b, 0x78, 0xe4, 0xfb, 0xbe, 0xdf, 0xf7, 0xee,
-	0xf9, 0xbb, 0x5c, 0x62, 0x57, 0x40, 0x57, 0x68, 0x93, 0x21, 0x0c, 0x62, 0xe8, 0xf5, 0x59, 0x36,
-	0x8c, 0x54, 0x86, 0x06, 0x83, 0x0d, 0x2b, 0x46, 0x4e, 0x8c, 0x06, 0x71, 0x65, 0x93, 0xa6, 0x42,
-	0x22, 0xd8, 0xaf, 0xf3, 0x54, 0x6a, 0x1d, 0xd4, 0x29, 0x6a, 0x48, 0xa8, 0x66, 0x2e, 0x0c, 0x83,
-	0x38, 0x61, 0x86, 0xc6, 0xa0, 0x28, 0x17, 0x92, 0x1a, 0x81, 0xb2, 0xf0, 0xee, 0xcd, 0x97, 0x29,
-	0x9a, 0xd1, 0x54, 0x17, 0x6a, 0x99, 0x23, 0x47, 0x3b, 0xc2, 0x6c, 0xfa, 0xcd, 0x20, 0xf2, 0x5b,
-	0x06, 0x54, 0x09, 0xa0, 0x52, 0xa2, 0xb1, 0xc0, 0x22, 0x53, 0x2d, 0xfb, 0x41, 0x6b, 0xd6, 0x79,
-	0x69, 0x41, 0x6d, 0xd6, 0xeb, 0x33, 0x6d, 0xaa, 0x2d, 0x7f, 0xeb, 0xcf, 0x5f, 0xad, 0x50, 0x6a,
-	0x16, 0x9c, 0xfb, 0x25, 0x57, 0xb8, 0x43, 0x0e, 0xc8, 0xe9, 0x7a, 0x63, 0x3b, 0x9a, 0x7b, 0x5f,
-	0xe4, 0x02, 0xcd, 0xb5, 0xf1, 0xfb, 0xbe, 0xf7, 0xfc, 0xf5, 0x52, 0x23, 0xed, 0x22, 0xd1, 0x78,
-	0x20, 0xfe, 0xaa, 0x65, 0x06, 0x23, 0xe2, 0x97, 0x9c, 0x2f, 0x38, 0x5c, 0x00, 0x2c, 0x2e, 0x53,
-	0x39, 0xfa, 0xdf, 0xe4, 0x76, 0xab, 0xd6, 0x47, 0xaf, 0x9f, 0x4f, 0x2b, 0x27, 0xc1, 0x31, 0x58,
-	0x77, 0x5d, 0x32, 0x73, 0x87, 0xd9, 0x0d, 0x2c, 0xbf, 0x58, 0xf3, 0x62, 0x3c, 0x0d, 0xc9, 0x64,
-	0x1a, 0x92, 0x8f, 0x69, 0x48, 0x1e, 0xf3, 0xd0, 0x9b, 0xe4, 0xa1, 0xf7, 0x96, 0x87, 0xde, 0x55,
-	0x9d, 0x0b, 0x73, 0xdd, 0x4f, 0xa2, 0x0e, 0xa6, 0x4b, 0x51, 0xf7, 0x3f, 0x30, 0x33, 0x54, 0x4c,
-	0x27, 0x25, 0x7b, 0xc7, 0xb3, 0xef, 0x00, 0x00, 0x00, 0xff, 0xff, 0x51, 0xe4, 0xc9, 0x67, 0x08,
-	0x02, 0x00, 0x00,
+func (m *QueryMintsRequest) Reset()         { *m = QueryMintsRequest{} }
+func (m *QueryMintsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintsRequest) ProtoMessage()    {}
+func (*QueryMintsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{2}
+}
+func (m *QueryMintsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintsRequest.Merge(m, src)
+}
+func (m *QueryMintsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintsRequest.DiscardUnknown(m)
 }
 
-// Reference imports to suppress errors if they are not otherwise used.
-var _ context.Context
-var _ grpc.ClientConn
+var xxx_messageInfo_QueryMintsRequest proto.InternalMessageInfo
 
-// This is a compile-time assertion to ensure that this generated file
-// is compatible with the grpc package it is being compiled against.
-const _ = grpc.SupportPackageIsVersion4
+func (m *QueryMintsRequest) GetSigner() string {
+	if m != nil {
+		return m.Signer
+	}
+	return ""
+}
 
-// QueryClient is the client API for Query service.
-//
-// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
-type QueryClient interface {
-	// Parameters queries the parameters of the module.
-	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+func (m *QueryMintsRequest) GetBlockHeight() int64 {
+	if m != nil {
+		return m.BlockHeight
+	}
+	return 0
 }
 
-type queryClient struct {
-	cc grpc1.ClientConn
+func (m *QueryMintsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func NewQueryClient(cc grpc1.ClientConn) QueryClient {
-	return &queryClient{cc}
+// QueryMintsResponse is response type for the Query/Mints RPC method.
+type QueryMintsResponse struct {
+	// mints holds the matching mint ledger entries.
+	Mints []MintRecord `protobuf:"bytes,1,rep,name=mints,proto3" json:"mints"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
 }
 
-func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
-	out := new(QueryParamsResponse)
-	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Params", in, out, opts...)
-	if err != nil {
-		return nil, err
+func (m *QueryMintsResponse) Reset()         { *m = QueryMintsResponse{} }
+func (m *QueryMintsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintsResponse) ProtoMessage()    {}
+func (*QueryMintsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{3}
+}
+func (m *QueryMintsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return out, nil
 }
-
-// QueryServer is the server API for Query service.
-type QueryServer interface {
-	// Parameters queries the parameters of the module.
-	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+func (m *QueryMintsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintsResponse.Merge(m, src)
+}
+func (m *QueryMintsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintsResponse.DiscardUnknown(m)
 }
 
-// UnimplementedQueryServer can be embedded to have forward compatible implementations.
-type UnimplementedQueryServer struct {
+var xxx_messageInfo_QueryMintsResponse proto.InternalMessageInfo
+
+func (m *QueryMintsResponse) GetMints() []MintRecord {
+	if m != nil {
+		return m.Mints
+	}
+	return nil
 }
 
-func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+func (m *QueryMintsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
 }
 
-func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
-	s.RegisterService(&_Query_serviceDesc, srv)
+// QueryMintRequest is request type for the Query/Mint RPC method.
+type QueryMintRequest struct {
+	// id is the sequence number of the mint.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
 }
 
-func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(QueryParamsRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(QueryServer).Params(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/gnodi.distro.v1.Query/Params",
+func (m *QueryMintRequest) Reset()         { *m = QueryMintRequest{} }
+func (m *QueryMintRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintRequest) ProtoMessage()    {}
+func (*QueryMintRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{4}
+}
+func (m *QueryMintRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+}
+func (m *QueryMintRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintRequest.Merge(m, src)
+}
+func (m *QueryMintRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintRequest proto.InternalMessageInfo
+
+func (m *QueryMintRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
 	}
-	return interceptor(ctx, in, info, handler)
+	return 0
 }
 
-var Query_serviceDesc = _Query_serviceDesc
-var _Query_serviceDesc = grpc.ServiceDesc{
-	ServiceName: "gnodi.distro.v1.Query",
-	HandlerType: (*QueryServer)(nil),
-	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "Params",
-			Handler:    _Query_Params_Handler,
-		},
-	},
-	Streams:  []grpc.StreamDesc{},
-	Metadata: "gnodi/distro/v1/query.proto",
+// QueryMintResponse is response type for the Query/Mint RPC method.
+type QueryMintResponse struct {
+	// mint holds the mint ledger entry.
+	Mint MintRecord `protobuf:"bytes,1,opt,name=mint,proto3" json:"mint"`
 }
 
-func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryMintResponse) Reset()         { *m = QueryMintResponse{} }
+func (m *QueryMintResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintResponse) ProtoMessage()    {}
+func (*QueryMintResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{5}
+}
+func (m *QueryMintResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryMintResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintResponse.Merge(m, src)
+}
+func (m *QueryMintResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintResponse.DiscardUnknown(m)
 }
 
-func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryMintResponse proto.InternalMessageInfo
+
+func (m *QueryMintResponse) GetMint() MintRecord {
+	if m != nil {
+		return m.Mint
+	}
+	return MintRecord{}
 }
 
-func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	return len(dAtA) - i, nil
+// QueryDistributionStatusRequest is request type for the
+// Query/DistributionStatus RPC method.
+type QueryDistributionStatusRequest struct {
 }
 
-func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
-	size := m.Size()
-	dAtA = make([]byte, size)
-	n, err := m.MarshalToSizedBuffer(dAtA[:size])
-	if err != nil {
-		return nil, err
+func (m *QueryDistributionStatusRequest) Reset()         { *m = QueryDistributionStatusRequest{} }
+func (m *QueryDistributionStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionStatusRequest) ProtoMessage()    {}
+func (*QueryDistributionStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{6}
+}
+func (m *QueryDistributionStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
 	}
-	return dAtA[:n], nil
+}
+func (m *QueryDistributionStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionStatusRequest.Merge(m, src)
+}
+func (m *QueryDistributionStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionStatusRequest.DiscardUnknown(m)
 }
 
-func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
-	size := m.Size()
-	return m.MarshalToSizedBuffer(dAtA[:size])
+var xxx_messageInfo_QueryDistributionStatusRequest proto.InternalMessageInfo
+
+// QueryDistributionStatusResponse is response type for the
+// Query/DistributionStatus RPC method.
+type QueryDistributionStatusResponse struct {
+	// block_time is the block time the status was computed at.
+	BlockTime time.Time `protobuf:"bytes,1,opt,name=block_time,json=blockTime,proto3,stdtime" json:"block_time"`
+	// current_period is the 1-based halving period at the block time. It is
+	// zero when the distribution has not started yet.
+	CurrentPeriod uint64 `protobuf:"varint,2,opt,name=current_period,json=currentPeriod,proto3" json:"current_period,omitempty"`
+	// period_start_date is the first day of the current period (YYYY-MM-DD).
+	PeriodStartDate string `protobuf:"bytes,3,opt,name=period_start_date,json=periodStartDate,proto3" json:"period_start_date,omitempty"`
+	// period_end_date is the last day of the current period (YYYY-MM-DD).
+	PeriodEndDate string `protobuf:"bytes,4,opt,name=period_end_date,json=periodEndDate,proto3" json:"period_end_date,omitempty"`
+	// days_elapsed is the number of whole days elapsed in the current period.
+	DaysElapsed uint64 `protobuf:"varint,5,opt,name=days_elapsed,json=daysElapsed,proto3" json:"days_elapsed,omitempty"`
+	// days_in_period is the number of days the current period spans.
+	DaysInPeriod uint64 `protobuf:"varint,6,opt,name=days_in_period,json=daysInPeriod,proto3" json:"days_in_period,omitempty"`
+	// period_limit is the amount distributable over the whole current period.
+	PeriodLimit cosmossdk_io_math.Int `protobuf:"bytes,7,opt,name=period_limit,json=periodLimit,proto3,customtype=cosmossdk.io/math.Int" json:"period_limit"`
+	// total_distributable is the cumulative distributable cap so far.
+	TotalDistributable cosmossdk_io_math.Int `protobuf:"bytes,8,opt,name=total_distributable,json=totalDistributable,proto3,customtype=cosmossdk.io/math.Int" json:"total_distributable"`
+	// current_supply is the current total supply of the distributed denom as
+	// reported by x/bank.
+	CurrentSupply cosmossdk_io_math.Int `protobuf:"bytes,9,opt,name=current_supply,json=currentSupply,proto3,customtype=cosmossdk.io/math.Int" json:"current_supply"`
+	// mintable is the amount that can still be minted at the block time.
+	Mintable cosmossdk_io_math.Int `protobuf:"bytes,10,opt,name=mintable,proto3,customtype=cosmossdk.io/math.Int" json:"mintable"`
+	// auto_mint_watermark records how far automatic minting has progressed.
+	AutoMintWatermark AutoMintWatermark `protobuf:"bytes,11,opt,name=auto_mint_watermark,json=autoMintWatermark,proto3" json:"auto_mint_watermark"`
+	// minted_supply is the cumulative amount minted by the module, which the
+	// distribution schedule caps.
+	MintedSupply cosmossdk_io_math.Int `protobuf:"bytes,12,opt,name=minted_supply,json=mintedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"minted_supply"`
 }
 
-func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
-	i := len(dAtA)
-	_ = i
-	var l int
-	_ = l
-	{
-		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+func (m *QueryDistributionStatusResponse) Reset()         { *m = QueryDistributionStatusResponse{} }
+func (m *QueryDistributionStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryDistributionStatusResponse) ProtoMessage()    {}
+func (*QueryDistributionStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{7}
+}
+func (m *QueryDistributionStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryDistributionStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryDistributionStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
 		if err != nil {
-			return 0, err
+			return nil, err
 		}
-		i -= size
-		i = encodeVarintQuery(dAtA, i, uint64(size))
+		return b[:n], nil
 	}
-	i--
-	dAtA[i] = 0xa
-	return len(dAtA) - i, nil
+}
+func (m *QueryDistributionStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryDistributionStatusResponse.Merge(m, src)
+}
+func (m *QueryDistributionStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryDistributionStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryDistributionStatusResponse.DiscardUnknown(m)
 }
 
-func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
-	offset -= sovQuery(v)
-	base := offset
-	for v >= 1<<7 {
-		dAtA[offset] = uint8(v&0x7f | 0x80)
-		v >>= 7
-		offset++
+var xxx_messageInfo_QueryDistributionStatusResponse proto.InternalMessageInfo
+
+func (m *QueryDistributionStatusResponse) GetBlockTime() time.Time {
+	if m != nil {
+		return m.BlockTime
 	}
-	dAtA[offset] = uint8(v)
-	return base
+	return time.Time{}
 }
-func (m *QueryParamsRequest) Size() (n int) {
-	if m == nil {
-		return 0
+
+func (m *QueryDistributionStatusResponse) GetCurrentPeriod() uint64 {
+	if m != nil {
+		return m.CurrentPeriod
 	}
-	var l int
-	_ = l
-	return n
+	return 0
 }
 
-func (m *QueryParamsResponse) Size() (n int) {
-	if m == nil {
-		return 0
+func (m *QueryDistributionStatusResponse) GetPeriodStartDate() string {
+	if m != nil {
+		return m.PeriodStartDate
 	}
-	var l int
-	_ = l
-	l = m.Params.Size()
-	n += 1 + l + sovQuery(uint64(l))
-	return n
+	return ""
 }
 
-func sovQuery(x uint64) (n int) {
+func (m *QueryDistributionStatusResponse) GetPeriodEndDate() string {
+	if m != nil {
+		return m.PeriodEndDate
+	}
+	return ""
+}
+
+func (m *QueryDistributionStatusResponse) GetDaysElapsed() uint64 {
+	if m != nil {
+		return m.DaysElapsed
+	}
+	return 0
+}
+
+func (m *QueryDistributionStatusResponse) GetDaysInPeriod() uint64 {
+	if m != nil {
+		return m.DaysInPeriod
+	}
+	return 0
+}
+
+func (m *QueryDistributionStatusResponse) GetAutoMintWatermark() AutoMintWatermark {
+	if m != nil {
+		return m.AutoMintWatermark
+	}
+	return AutoMintWatermark{}
+}
+
+// ScheduleOverride overrides schedule params for a projection. Zero-valued
+// fields keep the current param value.
+type ScheduleOverride struct {
+	// max_supply overrides Params.max_supply.
+	MaxSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=max_supply,json=maxSupply,proto3,customtype=cosmossdk.io/math.Int" json:"max_supply"`
+	// distribution_start_date overrides Params.distribution_start_date.
+	DistributionStartDate string `protobuf:"bytes,2,opt,name=distribution_start_date,json=distributionStartDate,proto3" json:"distribution_start_date,omitempty"`
+	// months_in_halving_period overrides Params.months_in_halving_period.
+	MonthsInHalvingPeriod uint64 `protobuf:"varint,3,opt,name=months_in_halving_period,json=monthsInHalvingPeriod,proto3" json:"months_in_halving_period,omitempty"`
+	// emission_curve overrides Params.emission_curve.
+	EmissionCurve *EmissionCurve `protobuf:"bytes,4,opt,name=emission_curve,json=emissionCurve,proto3" json:"emission_curve,omitempty"`
+}
+
+func (m *ScheduleOverride) Reset()         { *m = ScheduleOverride{} }
+func (m *ScheduleOverride) String() string { return proto.CompactTextString(m) }
+func (*ScheduleOverride) ProtoMessage()    {}
+func (*ScheduleOverride) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{8}
+}
+func (m *ScheduleOverride) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *ScheduleOverride) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_ScheduleOverride.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *ScheduleOverride) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ScheduleOverride.Merge(m, src)
+}
+func (m *ScheduleOverride) XXX_Size() int {
+	return m.Size()
+}
+func (m *ScheduleOverride) XXX_DiscardUnknown() {
+	xxx_messageInfo_ScheduleOverride.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ScheduleOverride proto.InternalMessageInfo
+
+func (m *ScheduleOverride) GetDistributionStartDate() string {
+	if m != nil {
+		return m.DistributionStartDate
+	}
+	return ""
+}
+
+func (m *ScheduleOverride) GetMonthsInHalvingPeriod() uint64 {
+	if m != nil {
+		return m.MonthsInHalvingPeriod
+	}
+	return 0
+}
+
+func (m *ScheduleOverride) GetEmissionCurve() *EmissionCurve {
+	if m != nil {
+		return m.EmissionCurve
+	}
+	return nil
+}
+
+// QueryProjectScheduleRequest is request type for the Query/ProjectSchedule
+// RPC method.
+type QueryProjectScheduleRequest struct {
+	// start_date is the first projected date (YYYY-MM-DD).
+	StartDate string `protobuf:"bytes,1,opt,name=start_date,json=startDate,proto3" json:"start_date,omitempty"`
+	// end_date is the last projected date (YYYY-MM-DD).
+	EndDate string `protobuf:"bytes,2,opt,name=end_date,json=endDate,proto3" json:"end_date,omitempty"`
+	// granularity is the step between two projected points.
+	Granularity ProjectionGranularity `protobuf:"varint,3,opt,name=granularity,proto3,enum=gnodi.distro.v1.ProjectionGranularity" json:"granularity,omitempty"`
+	// override optionally overrides the current schedule params.
+	Override *ScheduleOverride `protobuf:"bytes,4,opt,name=override,proto3" json:"override,omitempty"`
+}
+
+func (m *QueryProjectScheduleRequest) Reset()         { *m = QueryProjectScheduleRequest{} }
+func (m *QueryProjectScheduleRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectScheduleRequest) ProtoMessage()    {}
+func (*QueryProjectScheduleRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{9}
+}
+func (m *QueryProjectScheduleRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectScheduleRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectScheduleRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectScheduleRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectScheduleRequest.Merge(m, src)
+}
+func (m *QueryProjectScheduleRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectScheduleRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectScheduleRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectScheduleRequest proto.InternalMessageInfo
+
+func (m *QueryProjectScheduleRequest) GetStartDate() string {
+	if m != nil {
+		return m.StartDate
+	}
+	return ""
+}
+
+func (m *QueryProjectScheduleRequest) GetEndDate() string {
+	if m != nil {
+		return m.EndDate
+	}
+	return ""
+}
+
+func (m *QueryProjectScheduleRequest) GetGranularity() ProjectionGranularity {
+	if m != nil {
+		return m.Granularity
+	}
+	return ProjectionGranularity_PROJECTION_GRANULARITY_UNSPECIFIED
+}
+
+func (m *QueryProjectScheduleRequest) GetOverride() *ScheduleOverride {
+	if m != nil {
+		return m.Override
+	}
+	return nil
+}
+
+// SchedulePoint is the projected schedule state on a date.
+type SchedulePoint struct {
+	// date is the projected date (YYYY-MM-DD).
+	Date string `protobuf:"bytes,1,opt,name=date,proto3" json:"date,omitempty"`
+	// halving_period is the 1-based halving period on date, or zero before the
+	// distribution start date.
+	HalvingPeriod uint64 `protobuf:"varint,2,opt,name=halving_period,json=halvingPeriod,proto3" json:"halving_period,omitempty"`
+	// total_distributable is the cumulative distributable cap on date.
+	TotalDistributable cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=total_distributable,json=totalDistributable,proto3,customtype=cosmossdk.io/math.Int" json:"total_distributable"`
+}
+
+func (m *SchedulePoint) Reset()         { *m = SchedulePoint{} }
+func (m *SchedulePoint) String() string { return proto.CompactTextString(m) }
+func (*SchedulePoint) ProtoMessage()    {}
+func (*SchedulePoint) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{10}
+}
+func (m *SchedulePoint) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *SchedulePoint) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_SchedulePoint.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *SchedulePoint) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SchedulePoint.Merge(m, src)
+}
+func (m *SchedulePoint) XXX_Size() int {
+	return m.Size()
+}
+func (m *SchedulePoint) XXX_DiscardUnknown() {
+	xxx_messageInfo_SchedulePoint.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SchedulePoint proto.InternalMessageInfo
+
+func (m *SchedulePoint) GetDate() string {
+	if m != nil {
+		return m.Date
+	}
+	return ""
+}
+
+func (m *SchedulePoint) GetHalvingPeriod() uint64 {
+	if m != nil {
+		return m.HalvingPeriod
+	}
+	return 0
+}
+
+// QueryProjectScheduleResponse is response type for the Query/ProjectSchedule
+// RPC method.
+type QueryProjectScheduleResponse struct {
+	// points holds the projected schedule, ordered by date. The end date is
+	// always the last point.
+	Points []SchedulePoint `protobuf:"bytes,1,rep,name=points,proto3" json:"points"`
+}
+
+func (m *QueryProjectScheduleResponse) Reset()         { *m = QueryProjectScheduleResponse{} }
+func (m *QueryProjectScheduleResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryProjectScheduleResponse) ProtoMessage()    {}
+func (*QueryProjectScheduleResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{11}
+}
+func (m *QueryProjectScheduleResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryProjectScheduleResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryProjectScheduleResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryProjectScheduleResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryProjectScheduleResponse.Merge(m, src)
+}
+func (m *QueryProjectScheduleResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryProjectScheduleResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryProjectScheduleResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryProjectScheduleResponse proto.InternalMessageInfo
+
+func (m *QueryProjectScheduleResponse) GetPoints() []SchedulePoint {
+	if m != nil {
+		return m.Points
+	}
+	return nil
+}
+
+// QueryMintersRequest is request type for the Query/Minters RPC method.
+type QueryMintersRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintersRequest) Reset()         { *m = QueryMintersRequest{} }
+func (m *QueryMintersRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintersRequest) ProtoMessage()    {}
+func (*QueryMintersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{12}
+}
+func (m *QueryMintersRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintersRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintersRequest.Merge(m, src)
+}
+func (m *QueryMintersRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintersRequest proto.InternalMessageInfo
+
+func (m *QueryMintersRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintersResponse is response type for the Query/Minters RPC method.
+type QueryMintersResponse struct {
+	// minters holds the registered minters.
+	Minters []Minter `protobuf:"bytes,1,rep,name=minters,proto3" json:"minters"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintersResponse) Reset()         { *m = QueryMintersResponse{} }
+func (m *QueryMintersResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintersResponse) ProtoMessage()    {}
+func (*QueryMintersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{13}
+}
+func (m *QueryMintersResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintersResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintersResponse.Merge(m, src)
+}
+func (m *QueryMintersResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintersResponse proto.InternalMessageInfo
+
+func (m *QueryMintersResponse) GetMinters() []Minter {
+	if m != nil {
+		return m.Minters
+	}
+	return nil
+}
+
+func (m *QueryMintersResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMinterRequest is request type for the Query/Minter RPC method.
+type QueryMinterRequest struct {
+	// address is the minter address.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryMinterRequest) Reset()         { *m = QueryMinterRequest{} }
+func (m *QueryMinterRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterRequest) ProtoMessage()    {}
+func (*QueryMinterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{14}
+}
+func (m *QueryMinterRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterRequest.Merge(m, src)
+}
+func (m *QueryMinterRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterRequest proto.InternalMessageInfo
+
+func (m *QueryMinterRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryMinterResponse is response type for the Query/Minter RPC method.
+type QueryMinterResponse struct {
+	// minter is the registered minter.
+	Minter Minter `protobuf:"bytes,1,opt,name=minter,proto3" json:"minter"`
+	// minted_current_period is the amount minted by the minter during the
+	// halving period of the current block time.
+	MintedCurrentPeriod cosmossdk_io_math.Int `protobuf:"bytes,2,opt,name=minted_current_period,json=mintedCurrentPeriod,proto3,customtype=cosmossdk.io/math.Int" json:"minted_current_period"`
+	// minted_total is the amount minted by the minter across all periods.
+	MintedTotal cosmossdk_io_math.Int `protobuf:"bytes,3,opt,name=minted_total,json=mintedTotal,proto3,customtype=cosmossdk.io/math.Int" json:"minted_total"`
+}
+
+func (m *QueryMinterResponse) Reset()         { *m = QueryMinterResponse{} }
+func (m *QueryMinterResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMinterResponse) ProtoMessage()    {}
+func (*QueryMinterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{15}
+}
+func (m *QueryMinterResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMinterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMinterResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMinterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMinterResponse.Merge(m, src)
+}
+func (m *QueryMinterResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMinterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMinterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMinterResponse proto.InternalMessageInfo
+
+func (m *QueryMinterResponse) GetMinter() Minter {
+	if m != nil {
+		return m.Minter
+	}
+	return Minter{}
+}
+
+// QueryBurnedSupplyRequest is request type for the Query/BurnedSupply RPC
+// method.
+type QueryBurnedSupplyRequest struct {
+}
+
+func (m *QueryBurnedSupplyRequest) Reset()         { *m = QueryBurnedSupplyRequest{} }
+func (m *QueryBurnedSupplyRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyRequest) ProtoMessage()    {}
+func (*QueryBurnedSupplyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{16}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyRequest.Merge(m, src)
+}
+func (m *QueryBurnedSupplyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyRequest proto.InternalMessageInfo
+
+// QueryBurnedSupplyResponse is response type for the Query/BurnedSupply RPC
+// method.
+type QueryBurnedSupplyResponse struct {
+	// burned_supply is the cumulative amount burned through the module.
+	BurnedSupply cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=burned_supply,json=burnedSupply,proto3,customtype=cosmossdk.io/math.Int" json:"burned_supply"`
+}
+
+func (m *QueryBurnedSupplyResponse) Reset()         { *m = QueryBurnedSupplyResponse{} }
+func (m *QueryBurnedSupplyResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryBurnedSupplyResponse) ProtoMessage()    {}
+func (*QueryBurnedSupplyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{17}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryBurnedSupplyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryBurnedSupplyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryBurnedSupplyResponse.Merge(m, src)
+}
+func (m *QueryBurnedSupplyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryBurnedSupplyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryBurnedSupplyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryBurnedSupplyResponse proto.InternalMessageInfo
+
+// QueryAddressBurnsRequest is request type for the Query/AddressBurns RPC
+// method.
+type QueryAddressBurnsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAddressBurnsRequest) Reset()         { *m = QueryAddressBurnsRequest{} }
+func (m *QueryAddressBurnsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBurnsRequest) ProtoMessage()    {}
+func (*QueryAddressBurnsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{18}
+}
+func (m *QueryAddressBurnsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBurnsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBurnsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBurnsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBurnsRequest.Merge(m, src)
+}
+func (m *QueryAddressBurnsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBurnsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBurnsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBurnsRequest proto.InternalMessageInfo
+
+func (m *QueryAddressBurnsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAddressBurnsResponse is response type for the Query/AddressBurns RPC
+// method.
+type QueryAddressBurnsResponse struct {
+	// burns holds the cumulative amount burned per account.
+	Burns []AddressBurned `protobuf:"bytes,1,rep,name=burns,proto3" json:"burns"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryAddressBurnsResponse) Reset()         { *m = QueryAddressBurnsResponse{} }
+func (m *QueryAddressBurnsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBurnsResponse) ProtoMessage()    {}
+func (*QueryAddressBurnsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{19}
+}
+func (m *QueryAddressBurnsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBurnsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBurnsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBurnsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBurnsResponse.Merge(m, src)
+}
+func (m *QueryAddressBurnsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBurnsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBurnsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBurnsResponse proto.InternalMessageInfo
+
+func (m *QueryAddressBurnsResponse) GetBurns() []AddressBurned {
+	if m != nil {
+		return m.Burns
+	}
+	return nil
+}
+
+func (m *QueryAddressBurnsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryAddressBurnedRequest is request type for the Query/AddressBurned RPC
+// method.
+type QueryAddressBurnedRequest struct {
+	// address is the account to query.
+	Address string `protobuf:"bytes,1,opt,name=address,proto3" json:"address,omitempty"`
+}
+
+func (m *QueryAddressBurnedRequest) Reset()         { *m = QueryAddressBurnedRequest{} }
+func (m *QueryAddressBurnedRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBurnedRequest) ProtoMessage()    {}
+func (*QueryAddressBurnedRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{20}
+}
+func (m *QueryAddressBurnedRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBurnedRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBurnedRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBurnedRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBurnedRequest.Merge(m, src)
+}
+func (m *QueryAddressBurnedRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBurnedRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBurnedRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBurnedRequest proto.InternalMessageInfo
+
+func (m *QueryAddressBurnedRequest) GetAddress() string {
+	if m != nil {
+		return m.Address
+	}
+	return ""
+}
+
+// QueryAddressBurnedResponse is response type for the Query/AddressBurned RPC
+// method.
+type QueryAddressBurnedResponse struct {
+	// amount is the cumulative amount burned from the account.
+	Amount cosmossdk_io_math.Int `protobuf:"bytes,1,opt,name=amount,proto3,customtype=cosmossdk.io/math.Int" json:"amount"`
+}
+
+func (m *QueryAddressBurnedResponse) Reset()         { *m = QueryAddressBurnedResponse{} }
+func (m *QueryAddressBurnedResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryAddressBurnedResponse) ProtoMessage()    {}
+func (*QueryAddressBurnedResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{21}
+}
+func (m *QueryAddressBurnedResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryAddressBurnedResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryAddressBurnedResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryAddressBurnedResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryAddressBurnedResponse.Merge(m, src)
+}
+func (m *QueryAddressBurnedResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryAddressBurnedResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryAddressBurnedResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryAddressBurnedResponse proto.InternalMessageInfo
+
+// QueryPendingParamsUpdatesRequest is request type for the
+// Query/PendingParamsUpdates RPC method.
+type QueryPendingParamsUpdatesRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamsUpdatesRequest) Reset()         { *m = QueryPendingParamsUpdatesRequest{} }
+func (m *QueryPendingParamsUpdatesRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdatesRequest) ProtoMessage()    {}
+func (*QueryPendingParamsUpdatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{22}
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdatesRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdatesRequest.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdatesRequest proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdatesRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingParamsUpdatesResponse is response type for the
+// Query/PendingParamsUpdates RPC method.
+type QueryPendingParamsUpdatesResponse struct {
+	// updates holds the scheduled params updates in id order.
+	Updates []ScheduledParamsUpdate `protobuf:"bytes,1,rep,name=updates,proto3" json:"updates"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Reset()         { *m = QueryPendingParamsUpdatesResponse{} }
+func (m *QueryPendingParamsUpdatesResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdatesResponse) ProtoMessage()    {}
+func (*QueryPendingParamsUpdatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{23}
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdatesResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdatesResponse.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdatesResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdatesResponse) GetUpdates() []ScheduledParamsUpdate {
+	if m != nil {
+		return m.Updates
+	}
+	return nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryPendingParamsUpdateRequest is request type for the
+// Query/PendingParamsUpdate RPC method.
+type QueryPendingParamsUpdateRequest struct {
+	// id is the sequence number of the scheduled update.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryPendingParamsUpdateRequest) Reset()         { *m = QueryPendingParamsUpdateRequest{} }
+func (m *QueryPendingParamsUpdateRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdateRequest) ProtoMessage()    {}
+func (*QueryPendingParamsUpdateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{24}
+}
+func (m *QueryPendingParamsUpdateRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdateRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdateRequest.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdateRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdateRequest proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdateRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryPendingParamsUpdateResponse is response type for the
+// Query/PendingParamsUpdate RPC method.
+type QueryPendingParamsUpdateResponse struct {
+	// update holds the scheduled params update.
+	Update ScheduledParamsUpdate `protobuf:"bytes,1,opt,name=update,proto3" json:"update"`
+}
+
+func (m *QueryPendingParamsUpdateResponse) Reset()         { *m = QueryPendingParamsUpdateResponse{} }
+func (m *QueryPendingParamsUpdateResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPendingParamsUpdateResponse) ProtoMessage()    {}
+func (*QueryPendingParamsUpdateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{25}
+}
+func (m *QueryPendingParamsUpdateResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPendingParamsUpdateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPendingParamsUpdateResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPendingParamsUpdateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPendingParamsUpdateResponse.Merge(m, src)
+}
+func (m *QueryPendingParamsUpdateResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPendingParamsUpdateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPendingParamsUpdateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPendingParamsUpdateResponse proto.InternalMessageInfo
+
+func (m *QueryPendingParamsUpdateResponse) GetUpdate() ScheduledParamsUpdate {
+	if m != nil {
+		return m.Update
+	}
+	return ScheduledParamsUpdate{}
+}
+
+// QueryPauseStatusRequest is request type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusRequest struct {
+}
+
+func (m *QueryPauseStatusRequest) Reset()         { *m = QueryPauseStatusRequest{} }
+func (m *QueryPauseStatusRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusRequest) ProtoMessage()    {}
+func (*QueryPauseStatusRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{26}
+}
+func (m *QueryPauseStatusRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusRequest.Merge(m, src)
+}
+func (m *QueryPauseStatusRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusRequest proto.InternalMessageInfo
+
+// QueryPauseStatusResponse is response type for the Query/PauseStatus RPC
+// method.
+type QueryPauseStatusResponse struct {
+	// status is the current pause status.
+	Status PauseStatus `protobuf:"bytes,1,opt,name=status,proto3" json:"status"`
+}
+
+func (m *QueryPauseStatusResponse) Reset()         { *m = QueryPauseStatusResponse{} }
+func (m *QueryPauseStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryPauseStatusResponse) ProtoMessage()    {}
+func (*QueryPauseStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{27}
+}
+func (m *QueryPauseStatusResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryPauseStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryPauseStatusResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryPauseStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryPauseStatusResponse.Merge(m, src)
+}
+func (m *QueryPauseStatusResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryPauseStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryPauseStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryPauseStatusResponse proto.InternalMessageInfo
+
+func (m *QueryPauseStatusResponse) GetStatus() PauseStatus {
+	if m != nil {
+		return m.Status
+	}
+	return PauseStatus{}
+}
+
+// QueryMintProposalsRequest is request type for the Query/MintProposals RPC
+// method.
+type QueryMintProposalsRequest struct {
+	// pagination defines an optional pagination for the request.
+	Pagination *query.PageRequest `protobuf:"bytes,1,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintProposalsRequest) Reset()         { *m = QueryMintProposalsRequest{} }
+func (m *QueryMintProposalsRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalsRequest) ProtoMessage()    {}
+func (*QueryMintProposalsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{28}
+}
+func (m *QueryMintProposalsRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalsRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalsRequest.Merge(m, src)
+}
+func (m *QueryMintProposalsRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalsRequest proto.InternalMessageInfo
+
+func (m *QueryMintProposalsRequest) GetPagination() *query.PageRequest {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintProposalsResponse is response type for the Query/MintProposals RPC
+// method.
+type QueryMintProposalsResponse struct {
+	// proposals holds the pending mint proposals in id order.
+	Proposals []MintProposal `protobuf:"bytes,1,rep,name=proposals,proto3" json:"proposals"`
+	// pagination defines the pagination in the response.
+	Pagination *query.PageResponse `protobuf:"bytes,2,opt,name=pagination,proto3" json:"pagination,omitempty"`
+}
+
+func (m *QueryMintProposalsResponse) Reset()         { *m = QueryMintProposalsResponse{} }
+func (m *QueryMintProposalsResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalsResponse) ProtoMessage()    {}
+func (*QueryMintProposalsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{29}
+}
+func (m *QueryMintProposalsResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalsResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalsResponse.Merge(m, src)
+}
+func (m *QueryMintProposalsResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalsResponse proto.InternalMessageInfo
+
+func (m *QueryMintProposalsResponse) GetProposals() []MintProposal {
+	if m != nil {
+		return m.Proposals
+	}
+	return nil
+}
+
+func (m *QueryMintProposalsResponse) GetPagination() *query.PageResponse {
+	if m != nil {
+		return m.Pagination
+	}
+	return nil
+}
+
+// QueryMintProposalRequest is request type for the Query/MintProposal RPC
+// method.
+type QueryMintProposalRequest struct {
+	// id is the sequence number of the proposal.
+	Id uint64 `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+}
+
+func (m *QueryMintProposalRequest) Reset()         { *m = QueryMintProposalRequest{} }
+func (m *QueryMintProposalRequest) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalRequest) ProtoMessage()    {}
+func (*QueryMintProposalRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{30}
+}
+func (m *QueryMintProposalRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalRequest.Merge(m, src)
+}
+func (m *QueryMintProposalRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalRequest proto.InternalMessageInfo
+
+func (m *QueryMintProposalRequest) GetId() uint64 {
+	if m != nil {
+		return m.Id
+	}
+	return 0
+}
+
+// QueryMintProposalResponse is response type for the Query/MintProposal RPC
+// method.
+type QueryMintProposalResponse struct {
+	// proposal holds the pending mint proposal.
+	Proposal MintProposal `protobuf:"bytes,1,opt,name=proposal,proto3" json:"proposal"`
+}
+
+func (m *QueryMintProposalResponse) Reset()         { *m = QueryMintProposalResponse{} }
+func (m *QueryMintProposalResponse) String() string { return proto.CompactTextString(m) }
+func (*QueryMintProposalResponse) ProtoMessage()    {}
+func (*QueryMintProposalResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_27b0f6ceb4113d2c, []int{31}
+}
+func (m *QueryMintProposalResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *QueryMintProposalResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_QueryMintProposalResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *QueryMintProposalResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_QueryMintProposalResponse.Merge(m, src)
+}
+func (m *QueryMintProposalResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *QueryMintProposalResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_QueryMintProposalResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_QueryMintProposalResponse proto.InternalMessageInfo
+
+func (m *QueryMintProposalResponse) GetProposal() MintProposal {
+	if m != nil {
+		return m.Proposal
+	}
+	return MintProposal{}
+}
+
+func init() {
+	proto.RegisterEnum("gnodi.distro.v1.ProjectionGranularity", ProjectionGranularity_name, ProjectionGranularity_value)
+	proto.RegisterType((*QueryParamsRequest)(nil), "gnodi.distro.v1.QueryParamsRequest")
+	proto.RegisterType((*QueryParamsResponse)(nil), "gnodi.distro.v1.QueryParamsResponse")
+	proto.RegisterType((*QueryMintsRequest)(nil), "gnodi.distro.v1.QueryMintsRequest")
+	proto.RegisterType((*QueryMintsResponse)(nil), "gnodi.distro.v1.QueryMintsResponse")
+	proto.RegisterType((*QueryMintRequest)(nil), "gnodi.distro.v1.QueryMintRequest")
+	proto.RegisterType((*QueryMintResponse)(nil), "gnodi.distro.v1.QueryMintResponse")
+	proto.RegisterType((*QueryDistributionStatusRequest)(nil), "gnodi.distro.v1.QueryDistributionStatusRequest")
+	proto.RegisterType((*QueryDistributionStatusResponse)(nil), "gnodi.distro.v1.QueryDistributionStatusResponse")
+	proto.RegisterType((*ScheduleOverride)(nil), "gnodi.distro.v1.ScheduleOverride")
+	proto.RegisterType((*QueryProjectScheduleRequest)(nil), "gnodi.distro.v1.QueryProjectScheduleRequest")
+	proto.RegisterType((*SchedulePoint)(nil), "gnodi.distro.v1.SchedulePoint")
+	proto.RegisterType((*QueryProjectScheduleResponse)(nil), "gnodi.distro.v1.QueryProjectScheduleResponse")
+	proto.RegisterType((*QueryMintersRequest)(nil), "gnodi.distro.v1.QueryMintersRequest")
+	proto.RegisterType((*QueryMintersResponse)(nil), "gnodi.distro.v1.QueryMintersResponse")
+	proto.RegisterType((*QueryMinterRequest)(nil), "gnodi.distro.v1.QueryMinterRequest")
+	proto.RegisterType((*QueryMinterResponse)(nil), "gnodi.distro.v1.QueryMinterResponse")
+	proto.RegisterType((*QueryBurnedSupplyRequest)(nil), "gnodi.distro.v1.QueryBurnedSupplyRequest")
+	proto.RegisterType((*QueryBurnedSupplyResponse)(nil), "gnodi.distro.v1.QueryBurnedSupplyResponse")
+	proto.RegisterType((*QueryAddressBurnsRequest)(nil), "gnodi.distro.v1.QueryAddressBurnsRequest")
+	proto.RegisterType((*QueryAddressBurnsResponse)(nil), "gnodi.distro.v1.QueryAddressBurnsResponse")
+	proto.RegisterType((*QueryAddressBurnedRequest)(nil), "gnodi.distro.v1.QueryAddressBurnedRequest")
+	proto.RegisterType((*QueryAddressBurnedResponse)(nil), "gnodi.distro.v1.QueryAddressBurnedResponse")
+	proto.RegisterType((*QueryPendingParamsUpdatesRequest)(nil), "gnodi.distro.v1.QueryPendingParamsUpdatesRequest")
+	proto.RegisterType((*QueryPendingParamsUpdatesResponse)(nil), "gnodi.distro.v1.QueryPendingParamsUpdatesResponse")
+	proto.RegisterType((*QueryPendingParamsUpdateRequest)(nil), "gnodi.distro.v1.QueryPendingParamsUpdateRequest")
+	proto.RegisterType((*QueryPendingParamsUpdateResponse)(nil), "gnodi.distro.v1.QueryPendingParamsUpdateResponse")
+	proto.RegisterType((*QueryPauseStatusRequest)(nil), "gnodi.distro.v1.QueryPauseStatusRequest")
+	proto.RegisterType((*QueryPauseStatusResponse)(nil), "gnodi.distro.v1.QueryPauseStatusResponse")
+	proto.RegisterType((*QueryMintProposalsRequest)(nil), "gnodi.distro.v1.QueryMintProposalsRequest")
+	proto.RegisterType((*QueryMintProposalsResponse)(nil), "gnodi.distro.v1.QueryMintProposalsResponse")
+	proto.RegisterType((*QueryMintProposalRequest)(nil), "gnodi.distro.v1.QueryMintProposalRequest")
+	proto.RegisterType((*QueryMintProposalResponse)(nil), "gnodi.distro.v1.QueryMintProposalResponse")
+}
+
+func init() { proto.RegisterFile("gnodi/distro/v1/query.proto", fileDescriptor_27b0f6ceb4113d2c) }
+
+var fileDescriptor_27b0f6ceb4113d2c = []byte{
+	// 2025 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x59, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0xd7, 0x50, 0x12, 0x25, 0x3d, 0x92, 0xb2, 0x3c, 0xb2, 0x6a, 0x6a, 0x6d, 0x53, 0xd2, 0xda,
+	0x56, 0x64, 0xd9, 0xda, 0x15, 0x95, 0x38, 0x2e, 0x8c, 0x04, 0x85, 0x64, 0xc9, 0x16, 0x5b, 0xdb,
+	0x52, 0x28, 0x09, 0x41, 0x5a, 0x04, 0xc4, 0x90, 0x3b, 0xa1, 0x36, 0x22, 0x77, 0x99, 0xfd, 0x50,
+	0x2c, 0x04, 0x29, 0x8a, 0x1c, 0x7a, 0x6a, 0x81, 0x00, 0x01, 0xda, 0x83, 0x51, 0xb4, 0x05, 0x5a,
+	0xb4, 0x97, 0x00, 0x05, 0xda, 0x4b, 0x81, 0xa2, 0xbd, 0xf4, 0x90, 0x63, 0xd0, 0x5e, 0x8a, 0x1e,
+	0xd2, 0xc0, 0x2e, 0xd0, 0x7f, 0xa3, 0x98, 0x8f, 0xa5, 0x76, 0xc5, 0x5d, 0x91, 0x62, 0x74, 0x11,
+	0xb4, 0xf3, 0xbe, 0x7e, 0xef, 0x63, 0xde, 0xbc, 0x19, 0xc2, 0x95, 0xba, 0x65, 0x1b, 0xa6, 0x6e,
+	0x98, 0xae, 0xe7, 0xd8, 0xfa, 0x61, 0x51, 0xff, 0xc0, 0xa7, 0xce, 0x91, 0xd6, 0x72, 0x6c, 0xcf,
+	0xc6, 0x17, 0x38, 0x51, 0x13, 0x44, 0xed, 0xb0, 0xa8, 0x5c, 0x24, 0x4d, 0xd3, 0xb2, 0x75, 0xfe,
+	0x57, 0xf0, 0x28, 0x8b, 0x35, 0xdb, 0x6d, 0xda, 0xae, 0x5e, 0x25, 0x2e, 0x15, 0xc2, 0xfa, 0x61,
+	0xb1, 0x4a, 0x3d, 0x52, 0xd4, 0x5b, 0xa4, 0x6e, 0x5a, 0xc4, 0x33, 0x6d, 0x4b, 0xf2, 0x4e, 0x0b,
+	0xde, 0x0a, 0xff, 0xd2, 0xc5, 0x87, 0x24, 0x29, 0x27, 0x71, 0x54, 0x7d, 0xc7, 0x4a, 0xa2, 0x35,
+	0x4d, 0xcb, 0x93, 0xb4, 0xeb, 0x71, 0x34, 0x66, 0xa0, 0x65, 0xbb, 0xa4, 0x21, 0x99, 0xae, 0xc6,
+	0x31, 0x51, 0x27, 0x89, 0xda, 0x22, 0x0e, 0x69, 0xba, 0x49, 0x06, 0x04, 0xb5, 0xe2, 0xb7, 0x0c,
+	0xe2, 0x51, 0xc9, 0x74, 0xa5, 0x93, 0xc9, 0x77, 0x03, 0xe2, 0xa5, 0xba, 0x5d, 0xb7, 0x85, 0xcb,
+	0xec, 0xbf, 0xb6, 0x55, 0xdb, 0xae, 0x37, 0xa8, 0x4e, 0x5a, 0xa6, 0x4e, 0x2c, 0xcb, 0xf6, 0x78,
+	0xa0, 0x02, 0xab, 0x33, 0x92, 0xca, 0xbf, 0xaa, 0xfe, 0x7b, 0xba, 0x67, 0x36, 0xa9, 0xeb, 0x91,
+	0x66, 0x4b, 0x30, 0xa8, 0x97, 0x00, 0xbf, 0xc5, 0x82, 0xbd, 0xcd, 0xd1, 0x94, 0xe9, 0x07, 0x3e,
+	0x75, 0x3d, 0xf5, 0x2d, 0x98, 0x8c, 0xac, 0xba, 0x2d, 0xdb, 0x72, 0x29, 0xbe, 0x0f, 0x69, 0x81,
+	0x3a, 0x8f, 0x66, 0xd1, 0x42, 0x66, 0xe5, 0xb2, 0x76, 0x22, 0xb1, 0x9a, 0x10, 0x58, 0x1b, 0xfb,
+	0xe2, 0xab, 0x99, 0x81, 0xdf, 0xff, 0xef, 0x0f, 0x8b, 0xa8, 0x2c, 0x25, 0xd4, 0x9f, 0x21, 0xb8,
+	0xc8, 0x75, 0x3e, 0x31, 0x2d, 0x2f, 0x30, 0x84, 0xbf, 0x05, 0x69, 0xd7, 0xac, 0x5b, 0xd4, 0xe1,
+	0x1a, 0xc7, 0xca, 0xf2, 0x0b, 0xcf, 0x41, 0xb6, 0xda, 0xb0, 0x6b, 0x07, 0x95, 0x7d, 0x6a, 0xd6,
+	0xf7, 0xbd, 0x7c, 0x6a, 0x16, 0x2d, 0x0c, 0x96, 0x33, 0x7c, 0x6d, 0x93, 0x2f, 0xe1, 0x87, 0x00,
+	0xc7, 0x85, 0x91, 0x1f, 0xe4, 0x80, 0xe6, 0x35, 0x59, 0x0c, 0xac, 0x8a, 0x34, 0x51, 0x82, 0xb2,
+	0x8a, 0xb4, 0x6d, 0x52, 0xa7, 0xd2, 0x6c, 0x39, 0x24, 0xa9, 0x3e, 0x47, 0x80, 0xc3, 0xc0, 0xa4,
+	0xaf, 0x6f, 0xc0, 0x30, 0xcb, 0x2e, 0x73, 0x75, 0x70, 0x21, 0xb3, 0x72, 0xa5, 0xc3, 0x55, 0xc6,
+	0x5e, 0xa6, 0x35, 0xdb, 0x31, 0xc2, 0xee, 0x0a, 0x21, 0xfc, 0x28, 0x02, 0x2e, 0xc5, 0xc1, 0xbd,
+	0xd2, 0x15, 0x9c, 0x30, 0x1d, 0x41, 0xa7, 0xc2, 0x44, 0x1b, 0x5c, 0x10, 0xb4, 0x71, 0x48, 0x99,
+	0x06, 0x0f, 0xd8, 0x50, 0x39, 0x65, 0x1a, 0xea, 0x56, 0x28, 0xb2, 0xa1, 0x5c, 0x0d, 0x31, 0x28,
+	0x32, 0x53, 0xbd, 0xc2, 0xe7, 0x32, 0xea, 0x2c, 0x14, 0xb8, 0xc2, 0x75, 0xc6, 0x6d, 0x56, 0x7d,
+	0x86, 0x64, 0xc7, 0x23, 0x9e, 0xdf, 0x2e, 0x90, 0xbf, 0xa7, 0x61, 0x26, 0x91, 0x45, 0x22, 0xd8,
+	0x04, 0x10, 0x39, 0x64, 0x35, 0x27, 0x71, 0x28, 0x9a, 0x28, 0x48, 0x2d, 0x28, 0x48, 0x6d, 0x37,
+	0x28, 0xc8, 0xb5, 0x1c, 0x83, 0xf1, 0xe9, 0x7f, 0x66, 0x90, 0x80, 0x32, 0xc6, 0x85, 0x19, 0x19,
+	0xdf, 0x84, 0xf1, 0x9a, 0xef, 0x38, 0x94, 0xed, 0x48, 0xea, 0x98, 0xb6, 0xc1, 0x23, 0x3a, 0x54,
+	0xce, 0xc9, 0xd5, 0x6d, 0xbe, 0x88, 0x17, 0xe1, 0xa2, 0x20, 0x57, 0x5c, 0x8f, 0x38, 0x5e, 0x85,
+	0x6d, 0x2c, 0x5e, 0x18, 0x63, 0xe5, 0x0b, 0x82, 0xb0, 0xc3, 0xd6, 0xd7, 0x89, 0x47, 0xf1, 0x3c,
+	0xc8, 0xa5, 0x0a, 0xb5, 0x0c, 0xc1, 0x39, 0xc4, 0x39, 0x73, 0x62, 0x79, 0xc3, 0x32, 0x38, 0xdf,
+	0x1c, 0x64, 0x0d, 0x72, 0xe4, 0x56, 0x68, 0x83, 0xb4, 0x5c, 0x6a, 0xe4, 0x87, 0xb9, 0xe1, 0x0c,
+	0x5b, 0xdb, 0x10, 0x4b, 0xf8, 0x06, 0x8c, 0x73, 0x16, 0xd3, 0x0a, 0xd0, 0xa5, 0x39, 0x13, 0x17,
+	0x2c, 0x59, 0x12, 0xdc, 0x0e, 0x64, 0xa5, 0xc1, 0x86, 0xd9, 0x34, 0xbd, 0xfc, 0x08, 0xb3, 0xb6,
+	0xb6, 0xcc, 0x7c, 0xfe, 0xf7, 0x57, 0x33, 0x53, 0xa2, 0x34, 0x5c, 0xe3, 0x40, 0x33, 0x6d, 0xbd,
+	0x49, 0xbc, 0x7d, 0xad, 0x64, 0x79, 0xff, 0xf8, 0xd3, 0x12, 0x08, 0x02, 0xfb, 0x12, 0x61, 0xc9,
+	0x08, 0x2d, 0x8f, 0x99, 0x12, 0x4c, 0x60, 0xd2, 0xb3, 0x3d, 0xd2, 0xa8, 0x18, 0x41, 0x1a, 0x48,
+	0xb5, 0x41, 0xf3, 0xa3, 0x7d, 0xea, 0xc6, 0x5c, 0xd9, 0x7a, 0x58, 0x17, 0x7e, 0xfb, 0x38, 0xf6,
+	0xae, 0xdf, 0x6a, 0x35, 0x8e, 0xf2, 0x63, 0x7d, 0x6a, 0x0f, 0xb2, 0xb5, 0xc3, 0xd5, 0xe0, 0xc7,
+	0x30, 0xca, 0x8a, 0x8d, 0x03, 0x86, 0x3e, 0x55, 0xb6, 0x35, 0xe0, 0x77, 0x61, 0x92, 0xf8, 0x9e,
+	0x5d, 0xe1, 0x6d, 0xfb, 0x43, 0xe2, 0x51, 0xa7, 0x49, 0x9c, 0x83, 0x7c, 0x86, 0x57, 0x9d, 0xda,
+	0x51, 0xfd, 0xab, 0xbe, 0x67, 0xb3, 0x1d, 0xf0, 0x76, 0xc0, 0x19, 0xde, 0x04, 0x17, 0xc9, 0x49,
+	0x2a, 0xde, 0x83, 0x1c, 0xd3, 0x4c, 0x8d, 0x20, 0x08, 0xd9, 0x3e, 0x11, 0x67, 0x85, 0x1a, 0x11,
+	0x03, 0xf5, 0x79, 0x0a, 0x26, 0x76, 0x6a, 0xfb, 0xd4, 0xf0, 0x1b, 0x74, 0xeb, 0x90, 0x3a, 0x8e,
+	0x69, 0x50, 0xbc, 0x05, 0xd0, 0x24, 0xcf, 0x02, 0x43, 0xa8, 0x4f, 0x43, 0x63, 0x4d, 0xf2, 0x4c,
+	0x46, 0xfa, 0x75, 0xb8, 0x6c, 0x84, 0xb6, 0x69, 0x78, 0x77, 0xa4, 0x78, 0xcd, 0x4f, 0x19, 0xd1,
+	0x5d, 0x2c, 0xf7, 0xc8, 0x3d, 0xc8, 0x37, 0x6d, 0xcb, 0xdb, 0xe7, 0xa5, 0xbd, 0x4f, 0x1a, 0x87,
+	0xa6, 0x55, 0x0f, 0x4a, 0x7c, 0x90, 0x97, 0xf8, 0x94, 0xa0, 0x97, 0xac, 0x4d, 0x41, 0x95, 0xb5,
+	0xbe, 0x01, 0xe3, 0xb4, 0x69, 0xba, 0x2e, 0x33, 0x56, 0xf3, 0x9d, 0x43, 0xb1, 0xb7, 0x32, 0x2b,
+	0x85, 0x8e, 0x3c, 0x6c, 0x48, 0xb6, 0x07, 0x8c, 0xab, 0x9c, 0xa3, 0xe1, 0x4f, 0xf5, 0x6b, 0x04,
+	0x57, 0xc4, 0x31, 0xe4, 0xd8, 0xef, 0xd3, 0x9a, 0x17, 0x44, 0x2a, 0xe8, 0x83, 0xd7, 0x00, 0x42,
+	0xae, 0x88, 0x03, 0x64, 0xcc, 0x6d, 0xc3, 0x9f, 0x86, 0xd1, 0xf6, 0xde, 0x16, 0x7e, 0x8e, 0x50,
+	0xb9, 0xab, 0x37, 0x21, 0x53, 0x77, 0x88, 0xe5, 0x37, 0x88, 0x63, 0x7a, 0x47, 0xdc, 0x99, 0xf1,
+	0x95, 0xf9, 0xce, 0xd3, 0x4c, 0xd8, 0x35, 0x6d, 0xeb, 0xd1, 0x31, 0x77, 0x39, 0x2c, 0x8a, 0xdf,
+	0x84, 0x51, 0x5b, 0x26, 0x4e, 0x3a, 0x39, 0xd7, 0xa1, 0xe6, 0x64, 0x86, 0xcb, 0x6d, 0x11, 0xf5,
+	0x73, 0x04, 0xb9, 0x80, 0xbc, 0x6d, 0x9b, 0x96, 0x87, 0x31, 0x0c, 0x85, 0xdc, 0xe1, 0xff, 0xb3,
+	0xfe, 0x77, 0x22, 0xfc, 0xb2, 0xff, 0xed, 0x47, 0xc2, 0x9e, 0xd0, 0x0d, 0x06, 0xcf, 0xaf, 0x1b,
+	0xa8, 0x04, 0xae, 0xc6, 0x67, 0x44, 0xf6, 0xfc, 0x55, 0x48, 0xb7, 0xec, 0xd0, 0xb1, 0x59, 0x48,
+	0x0c, 0x06, 0xf7, 0x36, 0x3a, 0x28, 0x70, 0x41, 0xf5, 0x5d, 0x39, 0x7b, 0x3c, 0xe1, 0xb3, 0x55,
+	0x7b, 0x52, 0x88, 0x1e, 0xf7, 0xa8, 0xef, 0xe3, 0xfe, 0x17, 0x08, 0x2e, 0x45, 0xf5, 0xb7, 0x0f,
+	0xfc, 0x11, 0x31, 0xce, 0x05, 0xd8, 0x2f, 0xc7, 0x9e, 0x99, 0xd4, 0x09, 0x83, 0x0e, 0x44, 0xce,
+	0xef, 0xc0, 0xd7, 0x42, 0xd3, 0x08, 0x75, 0x02, 0xef, 0xf3, 0x30, 0x42, 0x0c, 0xc3, 0xa1, 0xae,
+	0x2b, 0x0b, 0x23, 0xf8, 0x54, 0x7f, 0x92, 0x8a, 0xc4, 0x2b, 0x3c, 0xab, 0x09, 0x6c, 0x89, 0xb3,
+	0x5a, 0xa7, 0x37, 0x52, 0x02, 0x1b, 0x30, 0x25, 0xbb, 0x5d, 0xcc, 0xb1, 0xdb, 0x4f, 0x29, 0x4d,
+	0x0a, 0x75, 0x0f, 0x22, 0xc7, 0xf5, 0x0e, 0xc8, 0x66, 0x58, 0xe1, 0x85, 0xd6, 0x77, 0x9d, 0x66,
+	0x84, 0x96, 0x5d, 0xa6, 0x44, 0x55, 0x20, 0xcf, 0xa3, 0xb1, 0xe6, 0x3b, 0x56, 0xd0, 0x66, 0x83,
+	0xa1, 0xc5, 0x81, 0xe9, 0x18, 0x9a, 0x8c, 0xd7, 0x1e, 0xe4, 0xaa, 0x7c, 0xfd, 0x9b, 0x36, 0xde,
+	0x6c, 0x35, 0xa4, 0x5e, 0xad, 0x4a, 0x3c, 0xab, 0x22, 0x5d, 0xcc, 0xf4, 0xb9, 0x97, 0xf4, 0x6f,
+	0x11, 0x4c, 0xc7, 0x18, 0x91, 0x8e, 0x7d, 0x07, 0x86, 0x19, 0xa2, 0xe4, 0x1d, 0x19, 0x92, 0xa2,
+	0xd1, 0x59, 0x96, 0xcb, 0x9d, 0x5f, 0x69, 0xdf, 0xed, 0x84, 0x49, 0x8d, 0xee, 0x15, 0xfe, 0x1e,
+	0x28, 0x71, 0x62, 0xed, 0x29, 0x33, 0x4d, 0x9a, 0xb6, 0x6f, 0x79, 0x7d, 0x27, 0x4c, 0xca, 0xab,
+	0xef, 0xc3, 0xac, 0xe8, 0x6d, 0xd4, 0x32, 0x58, 0x53, 0xe5, 0xf7, 0x96, 0x3d, 0x7e, 0x3d, 0x3b,
+	0xf7, 0x94, 0xfd, 0x19, 0xc1, 0xdc, 0x29, 0xc6, 0xa4, 0x6f, 0xdf, 0x83, 0x11, 0x71, 0x3d, 0x0c,
+	0x92, 0x37, 0x9f, 0xd8, 0x4e, 0x8d, 0xb0, 0x86, 0x48, 0x87, 0x92, 0x1a, 0xce, 0x2f, 0x8d, 0x45,
+	0x98, 0x49, 0x82, 0x9e, 0x74, 0x43, 0x69, 0x26, 0x87, 0xb6, 0xed, 0x6c, 0x09, 0xd2, 0x02, 0x6a,
+	0x3b, 0xac, 0x67, 0xf6, 0x55, 0x2a, 0x50, 0xa7, 0xe1, 0xb2, 0x30, 0xc7, 0x6e, 0xcf, 0xd1, 0x8b,
+	0xcb, 0x0f, 0x20, 0xdf, 0x49, 0x6a, 0xef, 0x94, 0xb4, 0xcb, 0x57, 0x24, 0x82, 0xab, 0x31, 0xd7,
+	0xdb, 0xb6, 0x54, 0xc4, 0xae, 0x10, 0x53, 0x6b, 0xb2, 0xc0, 0x59, 0x67, 0xdd, 0x96, 0x4f, 0x07,
+	0xe7, 0x5e, 0x3a, 0x9f, 0x23, 0x50, 0xe2, 0xac, 0x48, 0x27, 0x1e, 0xc2, 0x58, 0xf0, 0x6a, 0x11,
+	0x54, 0xcd, 0xb5, 0xd8, 0xd6, 0x1f, 0x88, 0x86, 0x1d, 0x39, 0x16, 0x3d, 0xbf, 0x72, 0x59, 0x94,
+	0x11, 0x0f, 0xdb, 0x4c, 0xaa, 0x13, 0x12, 0x13, 0xc0, 0xb6, 0x67, 0xeb, 0x30, 0x1a, 0xc0, 0x93,
+	0xe1, 0xeb, 0xdd, 0xb1, 0xb6, 0xe4, 0xe2, 0x6f, 0x10, 0x4c, 0xc5, 0xce, 0x75, 0x78, 0x1e, 0xd4,
+	0xed, 0xf2, 0xd6, 0x77, 0x37, 0x1e, 0xec, 0x96, 0xb6, 0x9e, 0x56, 0x1e, 0x95, 0x57, 0x9f, 0xee,
+	0x3d, 0x5e, 0x2d, 0x97, 0x76, 0xdf, 0xa9, 0xec, 0x3d, 0xdd, 0xd9, 0xde, 0x78, 0x50, 0x7a, 0x58,
+	0xda, 0x58, 0x9f, 0x18, 0xc0, 0x05, 0x50, 0x12, 0xf8, 0xd6, 0x57, 0xdf, 0x99, 0x40, 0x78, 0x16,
+	0xae, 0x26, 0xd0, 0x9f, 0x6c, 0x3d, 0xdd, 0xdd, 0x9c, 0x48, 0xe1, 0x39, 0xb8, 0x96, 0xc0, 0xb1,
+	0xbd, 0x51, 0x2e, 0x6d, 0xad, 0x4f, 0x0c, 0xae, 0xfc, 0x0d, 0xc3, 0x30, 0x0f, 0x05, 0xfe, 0x04,
+	0x41, 0x5a, 0x14, 0x3c, 0xbe, 0xde, 0xe1, 0x6f, 0xe7, 0xdb, 0x8d, 0x72, 0xe3, 0x74, 0x26, 0x11,
+	0x4c, 0x75, 0xe9, 0x93, 0x7f, 0xfe, 0xf7, 0xb3, 0xd4, 0x2b, 0xf8, 0xa6, 0xce, 0xb9, 0x97, 0x2c,
+	0xea, 0x7d, 0x68, 0x3b, 0x07, 0x7a, 0xfc, 0x2b, 0x15, 0xfe, 0x21, 0x0c, 0x3f, 0xe1, 0x0f, 0x1b,
+	0x6a, 0xbc, 0xf6, 0xf0, 0xa3, 0x8e, 0x72, 0xfd, 0x54, 0x1e, 0x09, 0xe0, 0x0e, 0x07, 0x30, 0x8f,
+	0x6f, 0x74, 0x01, 0x20, 0xde, 0x53, 0x7e, 0x84, 0x60, 0x88, 0xc9, 0xe3, 0xb9, 0x64, 0xdd, 0x81,
+	0x79, 0xf5, 0x34, 0x16, 0x69, 0xbd, 0xc8, 0xad, 0xdf, 0xc6, 0xb7, 0x7a, 0xb1, 0xae, 0x7f, 0x64,
+	0x1a, 0x1f, 0xe3, 0x3f, 0x22, 0xc0, 0x9d, 0xaf, 0x1d, 0x58, 0x8f, 0xb7, 0x96, 0xf8, 0x74, 0xa2,
+	0x2c, 0xf7, 0x2e, 0x20, 0xc1, 0xde, 0xe7, 0x60, 0x5f, 0xc3, 0x2b, 0x5d, 0xc0, 0x9e, 0xbc, 0xe4,
+	0x31, 0x78, 0xbf, 0x43, 0x70, 0xe1, 0xc4, 0xb0, 0x8e, 0xef, 0x24, 0x54, 0x48, 0xec, 0x2d, 0x4b,
+	0x59, 0xea, 0x91, 0x5b, 0x82, 0xbd, 0xc7, 0xc1, 0x16, 0xb1, 0xde, 0xad, 0xb0, 0x84, 0x7c, 0xc5,
+	0x0d, 0x50, 0xfd, 0x18, 0xc1, 0x88, 0x9c, 0xc9, 0xf1, 0x8d, 0xe4, 0x14, 0x1e, 0x5f, 0x09, 0x94,
+	0x9b, 0x5d, 0xb8, 0x24, 0x22, 0x8d, 0x23, 0x5a, 0xc0, 0xf3, 0x3d, 0xe4, 0x9a, 0x19, 0xff, 0x29,
+	0x82, 0xb4, 0xd0, 0x81, 0xaf, 0x9f, 0x66, 0xa1, 0xcb, 0x86, 0x8b, 0xce, 0xe3, 0xea, 0xb7, 0x39,
+	0x8a, 0x15, 0xbc, 0xdc, 0x1b, 0x0a, 0xfd, 0x23, 0x39, 0xfe, 0x7c, 0x8c, 0x9f, 0x23, 0xc8, 0x86,
+	0x47, 0x56, 0x7c, 0x2b, 0xde, 0x60, 0xcc, 0xc8, 0xab, 0x2c, 0xf6, 0xc2, 0x2a, 0x11, 0xbe, 0xc6,
+	0x11, 0x6a, 0xf8, 0x4e, 0x17, 0x84, 0x91, 0x31, 0x19, 0x7f, 0x86, 0x20, 0x1b, 0x9e, 0x3b, 0x93,
+	0xd0, 0xc5, 0x0c, 0xc0, 0xca, 0x62, 0x2f, 0xac, 0x67, 0xec, 0x17, 0x62, 0x66, 0xfd, 0x15, 0x82,
+	0x5c, 0x64, 0x5e, 0xc4, 0xdd, 0x6d, 0xb5, 0x67, 0x51, 0xe5, 0x76, 0x4f, 0xbc, 0x12, 0xd8, 0xeb,
+	0x1c, 0xd8, 0x32, 0xd6, 0x7a, 0x01, 0x16, 0x4a, 0xeb, 0x5f, 0x10, 0x5c, 0x8a, 0x9b, 0xfe, 0x70,
+	0x31, 0x61, 0xc3, 0x25, 0x8f, 0xa5, 0xca, 0xca, 0x59, 0x44, 0x24, 0xee, 0x37, 0x39, 0xee, 0x7b,
+	0xf8, 0x6e, 0xb7, 0x8d, 0x2a, 0x94, 0x54, 0x22, 0xbf, 0x57, 0xb8, 0xf8, 0xaf, 0x08, 0x26, 0x63,
+	0xf4, 0xe3, 0xe5, 0x9e, 0xa1, 0x04, 0xe0, 0x8b, 0x67, 0x90, 0x90, 0xd8, 0xd7, 0x38, 0xf6, 0x37,
+	0xf0, 0xfd, 0xbe, 0xb0, 0x8b, 0x7e, 0xfe, 0x73, 0x04, 0x99, 0xd0, 0x3c, 0x87, 0x17, 0x92, 0xce,
+	0xcd, 0x93, 0x33, 0xa4, 0x72, 0xab, 0x07, 0x4e, 0x09, 0xf4, 0x55, 0x0e, 0x74, 0x09, 0xdf, 0xee,
+	0x7a, 0xcc, 0xfa, 0x2e, 0x0d, 0x7a, 0xf6, 0x2f, 0x11, 0xe4, 0x22, 0xc3, 0x5d, 0x52, 0xf1, 0xc6,
+	0xcd, 0x99, 0xca, 0xed, 0x9e, 0x78, 0x25, 0xbe, 0xbb, 0x1c, 0x9f, 0x8e, 0x97, 0x7a, 0xe8, 0x4a,
+	0x95, 0xe3, 0xe1, 0xf0, 0xd7, 0x08, 0xb2, 0x61, 0x85, 0x49, 0x9b, 0x3e, 0x66, 0xe6, 0x53, 0x16,
+	0x7b, 0x61, 0x3d, 0xe3, 0xc9, 0x17, 0x85, 0xc7, 0xf3, 0xbb, 0xf6, 0xe8, 0x8b, 0x17, 0x05, 0xf4,
+	0xe5, 0x8b, 0x02, 0xfa, 0xfa, 0x45, 0x01, 0x7d, 0xfa, 0xb2, 0x30, 0xf0, 0xe5, 0xcb, 0xc2, 0xc0,
+	0xbf, 0x5e, 0x16, 0x06, 0xbe, 0xbf, 0x54, 0x37, 0xbd, 0x7d, 0xbf, 0xaa, 0xd5, 0xec, 0x66, 0xac,
+	0xde, 0x67, 0x81, 0x66, 0xef, 0xa8, 0x45, 0xdd, 0x6a, 0x9a, 0xff, 0x56, 0xf1, 0xea, 0xff, 0x07,
+	0x00, 0xed, 0xbe, 0x31, 0xdd, 0xe3, 0x1c, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// QueryClient is the client API for Query service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type QueryClient interface {
+	// Parameters queries the parameters of the module.
+	Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error)
+	// Mints queries the mint ledger, optionally filtered by signer or block
+	// height.
+	Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error)
+	// Mint queries a single mint ledger entry by id.
+	Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error)
+	// DistributionStatus queries the state of the distribution schedule at the
+	// current block time.
+	DistributionStatus(ctx context.Context, in *QueryDistributionStatusRequest, opts ...grpc.CallOption) (*QueryDistributionStatusResponse, error)
+	// ProjectSchedule projects the cumulative distributable cap between two
+	// dates under the current params, optionally with schedule overrides.
+	ProjectSchedule(ctx context.Context, in *QueryProjectScheduleRequest, opts ...grpc.CallOption) (*QueryProjectScheduleResponse, error)
+	// Minters queries the minter registry.
+	Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error)
+	// Minter queries a registered minter and its usage.
+	Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error)
+	// BurnedSupply queries the cumulative amount burned through the module.
+	BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error)
+	// AddressBurns queries the cumulative amounts burned per account.
+	AddressBurns(ctx context.Context, in *QueryAddressBurnsRequest, opts ...grpc.CallOption) (*QueryAddressBurnsResponse, error)
+	// AddressBurned queries the cumulative amount burned from an account.
+	AddressBurned(ctx context.Context, in *QueryAddressBurnedRequest, opts ...grpc.CallOption) (*QueryAddressBurnedResponse, error)
+	// PendingParamsUpdates queries the queue of scheduled params updates.
+	PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error)
+	// PendingParamsUpdate queries a scheduled params update by id.
+	PendingParamsUpdate(ctx context.Context, in *QueryPendingParamsUpdateRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdateResponse, error)
+	// PauseStatus queries whether minting is paused.
+	PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error)
+	// MintProposals queries the mint proposals that are waiting for approvals.
+	MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error)
+	// MintProposal queries a pending mint proposal by id.
+	MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error)
+}
+
+type queryClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewQueryClient(cc grpc1.ClientConn) QueryClient {
+	return &queryClient{cc}
+}
+
+func (c *queryClient) Params(ctx context.Context, in *QueryParamsRequest, opts ...grpc.CallOption) (*QueryParamsResponse, error) {
+	out := new(QueryParamsResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Params", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mints(ctx context.Context, in *QueryMintsRequest, opts ...grpc.CallOption) (*QueryMintsResponse, error) {
+	out := new(QueryMintsResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Mints", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Mint(ctx context.Context, in *QueryMintRequest, opts ...grpc.CallOption) (*QueryMintResponse, error) {
+	out := new(QueryMintResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Mint", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) DistributionStatus(ctx context.Context, in *QueryDistributionStatusRequest, opts ...grpc.CallOption) (*QueryDistributionStatusResponse, error) {
+	out := new(QueryDistributionStatusResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/DistributionStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) ProjectSchedule(ctx context.Context, in *QueryProjectScheduleRequest, opts ...grpc.CallOption) (*QueryProjectScheduleResponse, error) {
+	out := new(QueryProjectScheduleResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/ProjectSchedule", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minters(ctx context.Context, in *QueryMintersRequest, opts ...grpc.CallOption) (*QueryMintersResponse, error) {
+	out := new(QueryMintersResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Minters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) Minter(ctx context.Context, in *QueryMinterRequest, opts ...grpc.CallOption) (*QueryMinterResponse, error) {
+	out := new(QueryMinterResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/Minter", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) BurnedSupply(ctx context.Context, in *QueryBurnedSupplyRequest, opts ...grpc.CallOption) (*QueryBurnedSupplyResponse, error) {
+	out := new(QueryBurnedSupplyResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/BurnedSupply", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressBurns(ctx context.Context, in *QueryAddressBurnsRequest, opts ...grpc.CallOption) (*QueryAddressBurnsResponse, error) {
+	out := new(QueryAddressBurnsResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/AddressBurns", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) AddressBurned(ctx context.Context, in *QueryAddressBurnedRequest, opts ...grpc.CallOption) (*QueryAddressBurnedResponse, error) {
+	out := new(QueryAddressBurnedResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/AddressBurned", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingParamsUpdates(ctx context.Context, in *QueryPendingParamsUpdatesRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdatesResponse, error) {
+	out := new(QueryPendingParamsUpdatesResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/PendingParamsUpdates", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PendingParamsUpdate(ctx context.Context, in *QueryPendingParamsUpdateRequest, opts ...grpc.CallOption) (*QueryPendingParamsUpdateResponse, error) {
+	out := new(QueryPendingParamsUpdateResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/PendingParamsUpdate", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) PauseStatus(ctx context.Context, in *QueryPauseStatusRequest, opts ...grpc.CallOption) (*QueryPauseStatusResponse, error) {
+	out := new(QueryPauseStatusResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/PauseStatus", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposals(ctx context.Context, in *QueryMintProposalsRequest, opts ...grpc.CallOption) (*QueryMintProposalsResponse, error) {
+	out := new(QueryMintProposalsResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/MintProposals", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *queryClient) MintProposal(ctx context.Context, in *QueryMintProposalRequest, opts ...grpc.CallOption) (*QueryMintProposalResponse, error) {
+	out := new(QueryMintProposalResponse)
+	err := c.cc.Invoke(ctx, "/gnodi.distro.v1.Query/MintProposal", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// QueryServer is the server API for Query service.
+type QueryServer interface {
+	// Parameters queries the parameters of the module.
+	Params(context.Context, *QueryParamsRequest) (*QueryParamsResponse, error)
+	// Mints queries the mint ledger, optionally filtered by signer or block
+	// height.
+	Mints(context.Context, *QueryMintsRequest) (*QueryMintsResponse, error)
+	// Mint queries a single mint ledger entry by id.
+	Mint(context.Context, *QueryMintRequest) (*QueryMintResponse, error)
+	// DistributionStatus queries the state of the distribution schedule at the
+	// current block time.
+	DistributionStatus(context.Context, *QueryDistributionStatusRequest) (*QueryDistributionStatusResponse, error)
+	// ProjectSchedule projects the cumulative distributable cap between two
+	// dates under the current params, optionally with schedule overrides.
+	ProjectSchedule(context.Context, *QueryProjectScheduleRequest) (*QueryProjectScheduleResponse, error)
+	// Minters queries the minter registry.
+	Minters(context.Context, *QueryMintersRequest) (*QueryMintersResponse, error)
+	// Minter queries a registered minter and its usage.
+	Minter(context.Context, *QueryMinterRequest) (*QueryMinterResponse, error)
+	// BurnedSupply queries the cumulative amount burned through the module.
+	BurnedSupply(context.Context, *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error)
+	// AddressBurns queries the cumulative amounts burned per account.
+	AddressBurns(context.Context, *QueryAddressBurnsRequest) (*QueryAddressBurnsResponse, error)
+	// AddressBurned queries the cumulative amount burned from an account.
+	AddressBurned(context.Context, *QueryAddressBurnedRequest) (*QueryAddressBurnedResponse, error)
+	// PendingParamsUpdates queries the queue of scheduled params updates.
+	PendingParamsUpdates(context.Context, *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error)
+	// PendingParamsUpdate queries a scheduled params update by id.
+	PendingParamsUpdate(context.Context, *QueryPendingParamsUpdateRequest) (*QueryPendingParamsUpdateResponse, error)
+	// PauseStatus queries whether minting is paused.
+	PauseStatus(context.Context, *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error)
+	// MintProposals queries the mint proposals that are waiting for approvals.
+	MintProposals(context.Context, *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error)
+	// MintProposal queries a pending mint proposal by id.
+	MintProposal(context.Context, *QueryMintProposalRequest) (*QueryMintProposalResponse, error)
+}
+
+// UnimplementedQueryServer can be embedded to have forward compatible implementations.
+type UnimplementedQueryServer struct {
+}
+
+func (*UnimplementedQueryServer) Params(ctx context.Context, req *QueryParamsRequest) (*QueryParamsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Params not implemented")
+}
+func (*UnimplementedQueryServer) Mints(ctx context.Context, req *QueryMintsRequest) (*QueryMintsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mints not implemented")
+}
+func (*UnimplementedQueryServer) Mint(ctx context.Context, req *QueryMintRequest) (*QueryMintResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Mint not implemented")
+}
+func (*UnimplementedQueryServer) DistributionStatus(ctx context.Context, req *QueryDistributionStatusRequest) (*QueryDistributionStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DistributionStatus not implemented")
+}
+func (*UnimplementedQueryServer) ProjectSchedule(ctx context.Context, req *QueryProjectScheduleRequest) (*QueryProjectScheduleResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ProjectSchedule not implemented")
+}
+func (*UnimplementedQueryServer) Minters(ctx context.Context, req *QueryMintersRequest) (*QueryMintersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minters not implemented")
+}
+func (*UnimplementedQueryServer) Minter(ctx context.Context, req *QueryMinterRequest) (*QueryMinterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Minter not implemented")
+}
+func (*UnimplementedQueryServer) BurnedSupply(ctx context.Context, req *QueryBurnedSupplyRequest) (*QueryBurnedSupplyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method BurnedSupply not implemented")
+}
+func (*UnimplementedQueryServer) AddressBurns(ctx context.Context, req *QueryAddressBurnsRequest) (*QueryAddressBurnsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBurns not implemented")
+}
+func (*UnimplementedQueryServer) AddressBurned(ctx context.Context, req *QueryAddressBurnedRequest) (*QueryAddressBurnedResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddressBurned not implemented")
+}
+func (*UnimplementedQueryServer) PendingParamsUpdates(ctx context.Context, req *QueryPendingParamsUpdatesRequest) (*QueryPendingParamsUpdatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamsUpdates not implemented")
+}
+func (*UnimplementedQueryServer) PendingParamsUpdate(ctx context.Context, req *QueryPendingParamsUpdateRequest) (*QueryPendingParamsUpdateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PendingParamsUpdate not implemented")
+}
+func (*UnimplementedQueryServer) PauseStatus(ctx context.Context, req *QueryPauseStatusRequest) (*QueryPauseStatusResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PauseStatus not implemented")
+}
+func (*UnimplementedQueryServer) MintProposals(ctx context.Context, req *QueryMintProposalsRequest) (*QueryMintProposalsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposals not implemented")
+}
+func (*UnimplementedQueryServer) MintProposal(ctx context.Context, req *QueryMintProposalRequest) (*QueryMintProposalResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method MintProposal not implemented")
+}
+
+func RegisterQueryServer(s grpc1.Server, srv QueryServer) {
+	s.RegisterService(&_Query_serviceDesc, srv)
+}
+
+func _Query_Params_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryParamsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Params(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/Params",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Params(ctx, req.(*QueryParamsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mints_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mints(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/Mints",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mints(ctx, req.(*QueryMintsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Mint_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Mint(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/Mint",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Mint(ctx, req.(*QueryMintRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_DistributionStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryDistributionStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).DistributionStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/DistributionStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).DistributionStatus(ctx, req.(*QueryDistributionStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_ProjectSchedule_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryProjectScheduleRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).ProjectSchedule(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/ProjectSchedule",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).ProjectSchedule(ctx, req.(*QueryProjectScheduleRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/Minters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minters(ctx, req.(*QueryMintersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_Minter_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMinterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).Minter(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/Minter",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).Minter(ctx, req.(*QueryMinterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_BurnedSupply_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryBurnedSupplyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).BurnedSupply(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/BurnedSupply",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).BurnedSupply(ctx, req.(*QueryBurnedSupplyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressBurns_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressBurnsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressBurns(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/AddressBurns",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressBurns(ctx, req.(*QueryAddressBurnsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_AddressBurned_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryAddressBurnedRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).AddressBurned(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/AddressBurned",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).AddressBurned(ctx, req.(*QueryAddressBurnedRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParamsUpdates_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsUpdatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/PendingParamsUpdates",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamsUpdates(ctx, req.(*QueryPendingParamsUpdatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PendingParamsUpdate_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPendingParamsUpdateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PendingParamsUpdate(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/PendingParamsUpdate",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PendingParamsUpdate(ctx, req.(*QueryPendingParamsUpdateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_PauseStatus_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryPauseStatusRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).PauseStatus(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/PauseStatus",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).PauseStatus(ctx, req.(*QueryPauseStatusRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposals_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposals(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/MintProposals",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposals(ctx, req.(*QueryMintProposalsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _Query_MintProposal_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(QueryMintProposalRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(QueryServer).MintProposal(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/gnodi.distro.v1.Query/MintProposal",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(QueryServer).MintProposal(ctx, req.(*QueryMintProposalRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var Query_serviceDesc = _Query_serviceDesc
+var _Query_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "gnodi.distro.v1.Query",
+	HandlerType: (*QueryServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Params",
+			Handler:    _Query_Params_Handler,
+		},
+		{
+			MethodName: "Mints",
+			Handler:    _Query_Mints_Handler,
+		},
+		{
+			MethodName: "Mint",
+			Handler:    _Query_Mint_Handler,
+		},
+		{
+			MethodName: "DistributionStatus",
+			Handler:    _Query_DistributionStatus_Handler,
+		},
+		{
+			MethodName: "ProjectSchedule",
+			Handler:    _Query_ProjectSchedule_Handler,
+		},
+		{
+			MethodName: "Minters",
+			Handler:    _Query_Minters_Handler,
+		},
+		{
+			MethodName: "Minter",
+			Handler:    _Query_Minter_Handler,
+		},
+		{
+			MethodName: "BurnedSupply",
+			Handler:    _Query_BurnedSupply_Handler,
+		},
+		{
+			MethodName: "AddressBurns",
+			Handler:    _Query_AddressBurns_Handler,
+		},
+		{
+			MethodName: "AddressBurned",
+			Handler:    _Query_AddressBurned_Handler,
+		},
+		{
+			MethodName: "PendingParamsUpdates",
+			Handler:    _Query_PendingParamsUpdates_Handler,
+		},
+		{
+			MethodName: "PendingParamsUpdate",
+			Handler:    _Query_PendingParamsUpdate_Handler,
+		},
+		{
+			MethodName: "PauseStatus",
+			Handler:    _Query_PauseStatus_Handler,
+		},
+		{
+			MethodName: "MintProposals",
+			Handler:    _Query_MintProposals_Handler,
+		},
+		{
+			MethodName: "MintProposal",
+			Handler:    _Query_MintProposal_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "gnodi/distro/v1/query.proto",
+}
+
+func (m *QueryParamsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryParamsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryParamsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryParamsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Params.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.BlockHeight != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.BlockHeight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Signer) > 0 {
+		i -= len(m.Signer)
+		copy(dAtA[i:], m.Signer)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Signer)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Mints) > 0 {
+		for iNdEx := len(m.Mints) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Mints[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Mint.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryDistributionStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryDistributionStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryDistributionStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintedSupply.Size()
+		i -= size
+		if _, err := m.MintedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x62
+	{
+		size, err := m.AutoMintWatermark.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x5a
+	{
+		size := m.Mintable.Size()
+		i -= size
+		if _, err := m.Mintable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x52
+	{
+		size := m.CurrentSupply.Size()
+		i -= size
+		if _, err := m.CurrentSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x4a
+	{
+		size := m.TotalDistributable.Size()
+		i -= size
+		if _, err := m.TotalDistributable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x42
+	{
+		size := m.PeriodLimit.Size()
+		i -= size
+		if _, err := m.PeriodLimit.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x3a
+	if m.DaysInPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DaysInPeriod))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.DaysElapsed != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.DaysElapsed))
+		i--
+		dAtA[i] = 0x28
+	}
+	if len(m.PeriodEndDate) > 0 {
+		i -= len(m.PeriodEndDate)
+		copy(dAtA[i:], m.PeriodEndDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PeriodEndDate)))
+		i--
+		dAtA[i] = 0x22
+	}
+	if len(m.PeriodStartDate) > 0 {
+		i -= len(m.PeriodStartDate)
+		copy(dAtA[i:], m.PeriodStartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.PeriodStartDate)))
+		i--
+		dAtA[i] = 0x1a
+	}
+	if m.CurrentPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.CurrentPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	n6, err6 := github_com_cosmos_gogoproto_types.StdTimeMarshalTo(m.BlockTime, dAtA[i-github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime):])
+	if err6 != nil {
+		return 0, err6
+	}
+	i -= n6
+	i = encodeVarintQuery(dAtA, i, uint64(n6))
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *ScheduleOverride) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *ScheduleOverride) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *ScheduleOverride) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.EmissionCurve != nil {
+		{
+			size, err := m.EmissionCurve.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.MonthsInHalvingPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.MonthsInHalvingPeriod))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.DistributionStartDate) > 0 {
+		i -= len(m.DistributionStartDate)
+		copy(dAtA[i:], m.DistributionStartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.DistributionStartDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	{
+		size := m.MaxSupply.Size()
+		i -= size
+		if _, err := m.MaxSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectScheduleRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectScheduleRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectScheduleRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Override != nil {
+		{
+			size, err := m.Override.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x22
+	}
+	if m.Granularity != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Granularity))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.EndDate) > 0 {
+		i -= len(m.EndDate)
+		copy(dAtA[i:], m.EndDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.EndDate)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.StartDate) > 0 {
+		i -= len(m.StartDate)
+		copy(dAtA[i:], m.StartDate)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.StartDate)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *SchedulePoint) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *SchedulePoint) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *SchedulePoint) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.TotalDistributable.Size()
+		i -= size
+		if _, err := m.TotalDistributable.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	if m.HalvingPeriod != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.HalvingPeriod))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Date) > 0 {
+		i -= len(m.Date)
+		copy(dAtA[i:], m.Date)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Date)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryProjectScheduleResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryProjectScheduleResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryProjectScheduleResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for iNdEx := len(m.Points) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Points[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintersRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintersRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintersRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintersResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintersResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintersResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Minters) > 0 {
+		for iNdEx := len(m.Minters) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Minters[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMinterResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMinterResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMinterResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.MintedTotal.Size()
+		i -= size
+		if _, err := m.MintedTotal.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x1a
+	{
+		size := m.MintedCurrentPeriod.Size()
+		i -= size
+		if _, err := m.MintedCurrentPeriod.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0x12
+	{
+		size, err := m.Minter.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryBurnedSupplyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryBurnedSupplyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.BurnedSupply.Size()
+		i -= size
+		if _, err := m.BurnedSupply.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBurnsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBurnsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBurnsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBurnsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBurnsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBurnsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Burns) > 0 {
+		for iNdEx := len(m.Burns) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Burns[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBurnedRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBurnedRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBurnedRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Address) > 0 {
+		i -= len(m.Address)
+		copy(dAtA[i:], m.Address)
+		i = encodeVarintQuery(dAtA, i, uint64(len(m.Address)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryAddressBurnedResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryAddressBurnedResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryAddressBurnedResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size := m.Amount.Size()
+		i -= size
+		if _, err := m.Amount.MarshalTo(dAtA[i:]); err != nil {
+			return 0, err
+		}
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsUpdatesRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdatesRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdatesRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdatesResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdatesResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Updates) > 0 {
+		for iNdEx := len(m.Updates) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Updates[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsUpdateRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdateRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdateRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPendingParamsUpdateResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPendingParamsUpdateResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPendingParamsUpdateResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Update.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryPauseStatusResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryPauseStatusResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryPauseStatusResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Status.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalsRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalsRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalsRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalsResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalsResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalsResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		{
+			size, err := m.Pagination.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQuery(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Proposals) > 0 {
+		for iNdEx := len(m.Proposals) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Proposals[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintQuery(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0xa
+		}
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.Id != 0 {
+		i = encodeVarintQuery(dAtA, i, uint64(m.Id))
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *QueryMintProposalResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *QueryMintProposalResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *QueryMintProposalResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	{
+		size, err := m.Proposal.MarshalToSizedBuffer(dAtA[:i])
+		if err != nil {
+			return 0, err
+		}
+		i -= size
+		i = encodeVarintQuery(dAtA, i, uint64(size))
+	}
+	i--
+	dAtA[i] = 0xa
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintQuery(dAtA []byte, offset int, v uint64) int {
+	offset -= sovQuery(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *QueryParamsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryParamsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Params.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signer)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.BlockHeight != 0 {
+		n += 1 + sovQuery(uint64(m.BlockHeight))
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Mints) > 0 {
+		for _, e := range m.Mints {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMintResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Mint.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryDistributionStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryDistributionStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = github_com_cosmos_gogoproto_types.SizeOfStdTime(m.BlockTime)
+	n += 1 + l + sovQuery(uint64(l))
+	if m.CurrentPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.CurrentPeriod))
+	}
+	l = len(m.PeriodStartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.PeriodEndDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.DaysElapsed != 0 {
+		n += 1 + sovQuery(uint64(m.DaysElapsed))
+	}
+	if m.DaysInPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.DaysInPeriod))
+	}
+	l = m.PeriodLimit.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.TotalDistributable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.CurrentSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.Mintable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.AutoMintWatermark.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *ScheduleOverride) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.MaxSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = len(m.DistributionStartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.MonthsInHalvingPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.MonthsInHalvingPeriod))
+	}
+	if m.EmissionCurve != nil {
+		l = m.EmissionCurve.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryProjectScheduleRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.StartDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	l = len(m.EndDate)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.Granularity != 0 {
+		n += 1 + sovQuery(uint64(m.Granularity))
+	}
+	if m.Override != nil {
+		l = m.Override.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *SchedulePoint) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Date)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	if m.HalvingPeriod != 0 {
+		n += 1 + sovQuery(uint64(m.HalvingPeriod))
+	}
+	l = m.TotalDistributable.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryProjectScheduleResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Points) > 0 {
+		for _, e := range m.Points {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *QueryMintersRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintersResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Minters) > 0 {
+		for _, e := range m.Minters {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMinterResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Minter.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedCurrentPeriod.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	l = m.MintedTotal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryBurnedSupplyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryBurnedSupplyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.BurnedSupply.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryAddressBurnsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressBurnsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Burns) > 0 {
+		for _, e := range m.Burns {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressBurnedRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Address)
+	if l > 0 {
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryAddressBurnedResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Amount.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPendingParamsUpdatesRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingParamsUpdatesResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Updates) > 0 {
+		for _, e := range m.Updates {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryPendingParamsUpdateRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryPendingParamsUpdateResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Update.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryPauseStatusRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	return n
+}
+
+func (m *QueryPauseStatusResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Status.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func (m *QueryMintProposalsRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintProposalsResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if len(m.Proposals) > 0 {
+		for _, e := range m.Proposals {
+			l = e.Size()
+			n += 1 + l + sovQuery(uint64(l))
+		}
+	}
+	if m.Pagination != nil {
+		l = m.Pagination.Size()
+		n += 1 + l + sovQuery(uint64(l))
+	}
+	return n
+}
+
+func (m *QueryMintProposalRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Id != 0 {
+		n += 1 + sovQuery(uint64(m.Id))
+	}
+	return n
+}
+
+func (m *QueryMintProposalResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = m.Proposal.Size()
+	n += 1 + l + sovQuery(uint64(l))
+	return n
+}
+
+func sovQuery(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
-func sozQuery(x uint64) (n int) {
-	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+func sozQuery(x uint64) (n int) {
+	return sovQuery(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signer", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signer = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockHeight", wireType)
+			}
+			m.BlockHeight = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BlockHeight |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mints", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Mints = append(m.Mints, MintRecord{})
+			if err := m.Mints[len(m.Mints)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mint", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mint.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryDistributionStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryDistributionStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryDistributionStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BlockTime", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := github_com_cosmos_gogoproto_types.StdTimeUnmarshal(&m.BlockTime, dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentPeriod", wireType)
+			}
+			m.CurrentPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CurrentPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodStartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodStartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodEndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PeriodEndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysElapsed", wireType)
+			}
+			m.DaysElapsed = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysElapsed |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DaysInPeriod", wireType)
+			}
+			m.DaysInPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.DaysInPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PeriodLimit", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.PeriodLimit.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CurrentSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.CurrentSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Mintable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Mintable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field AutoMintWatermark", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.AutoMintWatermark.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *ScheduleOverride) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: ScheduleOverride: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: ScheduleOverride: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MaxSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DistributionStartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.DistributionStartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MonthsInHalvingPeriod", wireType)
+			}
+			m.MonthsInHalvingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MonthsInHalvingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EmissionCurve", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.EmissionCurve == nil {
+				m.EmissionCurve = &EmissionCurve{}
+			}
+			if err := m.EmissionCurve.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectScheduleRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectScheduleRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectScheduleRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field StartDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.StartDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field EndDate", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.EndDate = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Granularity", wireType)
+			}
+			m.Granularity = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Granularity |= ProjectionGranularity(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Override", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Override == nil {
+				m.Override = &ScheduleOverride{}
+			}
+			if err := m.Override.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *SchedulePoint) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: SchedulePoint: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: SchedulePoint: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Date", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Date = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HalvingPeriod", wireType)
+			}
+			m.HalvingPeriod = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.HalvingPeriod |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TotalDistributable", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.TotalDistributable.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryProjectScheduleResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryProjectScheduleResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryProjectScheduleResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Points", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Points = append(m.Points, SchedulePoint{})
+			if err := m.Points[len(m.Points)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintersRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintersRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintersRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintersResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintersResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintersResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minters", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Minters = append(m.Minters, Minter{})
+			if err := m.Minters[len(m.Minters)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMinterResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMinterResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMinterResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Minter", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Minter.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedCurrentPeriod", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedCurrentPeriod.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MintedTotal", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.MintedTotal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryBurnedSupplyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryBurnedSupplyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BurnedSupply", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.BurnedSupply.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBurnsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBurnsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBurnsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBurnsResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBurnsResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBurnsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Burns", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Burns = append(m.Burns, AddressBurned{})
+			if err := m.Burns[len(m.Burns)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBurnedRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBurnedRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBurnedRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Address", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Address = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryAddressBurnedResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryAddressBurnedResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryAddressBurnedResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Amount", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Amount.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsUpdatesRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsUpdatesResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdatesResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Updates", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Updates = append(m.Updates, ScheduledParamsUpdate{})
+			if err := m.Updates[len(m.Updates)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsUpdateRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdateRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdateRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPendingParamsUpdateResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdateResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPendingParamsUpdateResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Update", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Update.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryPauseStatusResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryPauseStatusResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Status", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if err := m.Status.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalsRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalsRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageRequest{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
 }
-func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
+func (m *QueryMintProposalsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -345,12 +7364,151 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintProposalsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintProposalsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposals", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Proposals = append(m.Proposals, MintProposal{})
+			if err := m.Proposals[len(m.Proposals)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Pagination", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQuery
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Pagination == nil {
+				m.Pagination = &query.PageResponse{}
+			}
+			if err := m.Pagination.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQuery(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQuery
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *QueryMintProposalRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQuery
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: QueryMintProposalRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: QueryMintProposalRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Id", wireType)
+			}
+			m.Id = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQuery
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Id |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipQuery(dAtA[iNdEx:])
@@ -372,7 +7530,7 @@ func (m *QueryParamsRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
+func (m *QueryMintProposalResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -395,15 +7553,15 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: QueryParamsResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: QueryMintProposalResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: QueryParamsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: QueryMintProposalResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Params", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Proposal", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -430,7 +7588,7 @@ func (m *QueryParamsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if err := m.Params.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Proposal.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -0,0 +1,55 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+)
+
+// NewRecipient creates a new Recipient for an account address.
+func NewRecipient(address string, weight math.LegacyDec) Recipient {
+	return Recipient{
+		Address: address,
+		Weight:  weight,
+	}
+}
+
+// NewModuleRecipient creates a new Recipient for a module account.
+func NewModuleRecipient(module string, weight math.LegacyDec) Recipient {
+	return Recipient{
+		Module: module,
+		Weight: weight,
+	}
+}
+
+// Validate validates the recipient.
+func (r Recipient) Validate() error {
+	switch {
+	case r.Address == "" && r.Module == "":
+		return fmt.Errorf("recipient must set either an address or a module")
+	case r.Address != "" && r.Module != "":
+		return fmt.Errorf("recipient cannot set both address %s and module %s", r.Address, r.Module)
+	case r.Address != "":
+		if _, err := sdk.AccAddressFromBech32(r.Address); err != nil {
+			return fmt.Errorf("invalid recipient address: %w", err)
+		}
+	}
+
+	if r.Weight.IsNil() || !r.Weight.IsPositive() || r.Weight.GT(math.LegacyOneDec()) {
+		return fmt.Errorf("recipient weight must be greater than 0 and at most 1, got %s", r.Weight)
+	}
+	return nil
+}
+
+// SplitAmount splits amount across recipients by weight. Every part is rounded
+// down; the remainder is returned as dust.
+func SplitAmount(recipients []Recipient, amount math.Int) (parts []math.Int, dust math.Int) {
+	dust = amount
+	parts = make([]math.Int, len(recipients))
+	for i, r := range recipients {
+		parts[i] = math.LegacyNewDecFromInt(amount).Mul(r.Weight).TruncateInt()
+		dust = dust.Sub(parts[i])
+	}
+	return parts, dust
+}
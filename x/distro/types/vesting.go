@@ -0,0 +1,101 @@
+package types
+
+import (
+	"fmt"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	vestingtypes "github.com/cosmos/cosmos-sdk/x/auth/vesting/types"
+)
+
+func NewMsgMintContinuousVesting(signer, recipient string, amount math.Int, startTime, endTime int64) *MsgMintVesting {
+	return &MsgMintVesting{
+		Signer:      signer,
+		Recipient:   recipient,
+		Amount:      amount,
+		VestingType: VestingType_VESTING_TYPE_CONTINUOUS,
+		StartTime:   startTime,
+		EndTime:     endTime,
+	}
+}
+
+func NewMsgMintDelayedVesting(signer, recipient string, amount math.Int, endTime int64) *MsgMintVesting {
+	return &MsgMintVesting{
+		Signer:      signer,
+		Recipient:   recipient,
+		Amount:      amount,
+		VestingType: VestingType_VESTING_TYPE_DELAYED,
+		EndTime:     endTime,
+	}
+}
+
+func NewMsgMintPeriodicVesting(signer, recipient string, amount math.Int, startTime int64, periods []vestingtypes.Period) *MsgMintVesting {
+	return &MsgMintVesting{
+		Signer:      signer,
+		Recipient:   recipient,
+		Amount:      amount,
+		VestingType: VestingType_VESTING_TYPE_PERIODIC,
+		StartTime:   startTime,
+		Periods:     periods,
+	}
+}
+
+// WithReference stores reference in the mint record.
+func (msg *MsgMintVesting) WithReference(reference string) *MsgMintVesting {
+	msg.Reference = reference
+	return msg
+}
+
+// ValidateSchedule checks that the vesting schedule of msg matches its
+// vesting type and, for periodic vesting, that the periods add up to the
+// amount of denom.
+func (msg *MsgMintVesting) ValidateSchedule(denom string) error {
+	switch msg.VestingType {
+	case VestingType_VESTING_TYPE_CONTINUOUS:
+		if msg.StartTime <= 0 {
+			return fmt.Errorf("continuous vesting requires a start time")
+		}
+		if msg.EndTime <= msg.StartTime {
+			return fmt.Errorf("end time must be after start time")
+		}
+		if len(msg.Periods) > 0 {
+			return fmt.Errorf("continuous vesting takes no periods")
+		}
+	case VestingType_VESTING_TYPE_DELAYED:
+		if msg.EndTime <= 0 {
+			return fmt.Errorf("delayed vesting requires an end time")
+		}
+		if msg.StartTime != 0 {
+			return fmt.Errorf("delayed vesting takes no start time")
+		}
+		if len(msg.Periods) > 0 {
+			return fmt.Errorf("delayed vesting takes no periods")
+		}
+	case VestingType_VESTING_TYPE_PERIODIC:
+		if msg.StartTime <= 0 {
+			return fmt.Errorf("periodic vesting requires a start time")
+		}
+		if msg.EndTime != 0 {
+			return fmt.Errorf("periodic vesting takes no end time, it follows from the periods")
+		}
+		if len(msg.Periods) == 0 {
+			return fmt.Errorf("periodic vesting requires periods")
+		}
+		total := sdk.NewCoins()
+		for i, period := range msg.Periods {
+			if period.Length <= 0 {
+				return fmt.Errorf("period %d length must be positive", i)
+			}
+			if !period.Amount.IsValid() || period.Amount.Empty() {
+				return fmt.Errorf("period %d amount must be positive", i)
+			}
+			total = total.Add(period.Amount...)
+		}
+		if !total.Equal(sdk.NewCoins(sdk.NewCoin(denom, msg.Amount))) {
+			return fmt.Errorf("periods add up to %s, expected %s%s", total, msg.Amount, denom)
+		}
+	default:
+		return fmt.Errorf("invalid vesting type %d", msg.VestingType)
+	}
+	return nil
+}